@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.21
-	github.com/aws/aws-sdk-go-v2 v1.27.2
+	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/aws/aws-sdk-go-v2/config v1.27.18
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.18
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.5
@@ -33,8 +33,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.20.11
 	github.com/aws/aws-sdk-go-v2/service/batch v1.38.1
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.10
-	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.7
-	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.12.2
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.12.0
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.15.0
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.6
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.2.3
 	github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines v1.15.11
@@ -111,7 +111,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/keyspaces v1.10.10
 	github.com/aws/aws-sdk-go-v2/service/kinesis v1.27.10
 	github.com/aws/aws-sdk-go-v2/service/kms v1.32.3
-	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.33.3
+	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.41.0
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.54.6
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.4.2
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.43.10
@@ -188,7 +188,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.6
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.6
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.10
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.22.2
 	github.com/beevik/etree v1.4.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -242,8 +242,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.9 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.9 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.9 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
@@ -17,6 +17,7 @@ import (
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
@@ -40,6 +41,8 @@ type AWSClient struct {
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	customPartition           *Partition         // From partition metadata file.
+	partitionMetadata         *PartitionMetadata // From partition metadata file.
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -49,6 +52,7 @@ type AWSClient struct {
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	serviceQuotasPreflight    bool   // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -145,6 +149,11 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// ServiceQuotasPreflight returns the service_quotas_preflight provider configuration value.
+func (c *AWSClient) ServiceQuotasPreflight(context.Context) bool {
+	return c.serviceQuotasPreflight
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -187,6 +196,9 @@ func (c *AWSClient) APIGatewayV2InvokeURL(ctx context.Context, protocolType apig
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
 // for Amazon CloudFront distributions in the configured AWS partition.
 func (c *AWSClient) CloudFrontDistributionHostedZoneID(context.Context) string {
+	if p := c.customPartition; p != nil && p.CloudFrontDistributionHostedZoneID != "" {
+		return p.CloudFrontDistributionHostedZoneID
+	}
 	if c.Partition == names.ChinaPartitionID {
 		return "Z3RFFRIM2A3IF5" // See https://docs.amazonaws.cn/en_us/aws/latest/userguide/route53.html
 	}
@@ -217,9 +229,48 @@ func (c *AWSClient) DefaultKMSKeyPolicy(context.Context) string {
 // GlobalAcceleratorHostedZoneID returns the Route 53 hosted zone ID
 // for AWS Global Accelerator accelerators in the configured AWS partition.
 func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
+	if p := c.customPartition; p != nil && p.GlobalAcceleratorHostedZoneID != "" {
+		return p.GlobalAcceleratorHostedZoneID
+	}
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
+// CustomPartitionRegion returns the metadata for the specified AWS Region if it is in the
+// configured AWS partition and that partition was loaded from a partition metadata file.
+func (c *AWSClient) CustomPartitionRegion(_ context.Context, region string) (PartitionRegion, bool) {
+	if c.customPartition == nil {
+		return PartitionRegion{}, false
+	}
+
+	if v, ok := c.customPartition.Regions[region]; ok {
+		return v, true
+	}
+
+	if re := c.customPartition.regionRegex; re != nil && re.MatchString(region) {
+		return PartitionRegion{}, true
+	}
+
+	return PartitionRegion{}, false
+}
+
+// PartitionForRegion returns the AWS partition containing the specified Region.
+// Partitions loaded from a partition metadata file take precedence over the AWS SDK's embedded partitions,
+// for which only the partition ID and DNS suffix are returned.
+func (c *AWSClient) PartitionForRegion(_ context.Context, region string) (*Partition, bool) {
+	if p, ok := c.partitionMetadata.PartitionForRegion(region); ok {
+		return p, true
+	}
+
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		return &Partition{
+			ID:        p.ID(),
+			DNSSuffix: p.DNSSuffix(),
+		}, true
+	}
+
+	return nil, false
+}
+
 // DNSSuffix returns the domain suffix for the configured AWS partition.
 func (c *AWSClient) DNSSuffix(context.Context) string {
 	return c.dnsSuffix
@@ -227,12 +278,18 @@ func (c *AWSClient) DNSSuffix(context.Context) string {
 
 // ReverseDNSPrefix returns the reverse DNS prefix for the configured AWS partition.
 func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
+	if p := c.customPartition; p != nil && p.ReverseDNSPrefix != "" {
+		return p.ReverseDNSPrefix
+	}
 	return names.ReverseDNS(c.DNSSuffix(ctx))
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 	region := c.Region
+	if p := c.customPartition; p != nil && p.EC2RegionalPrivateDNSSuffix != "" {
+		return p.expand(p.EC2RegionalPrivateDNSSuffix, names.EC2, region)
+	}
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -243,6 +300,9 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
 	region := c.Region
+	if p := c.customPartition; p != nil && p.EC2RegionalPublicDNSSuffix != "" {
+		return p.expand(p.EC2RegionalPublicDNSSuffix, names.EC2, region)
+	}
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -294,6 +354,15 @@ func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName stri
 		return endpoint
 	}
 
+	// Endpoints from partition metadata are used only if not overridden by environment variables.
+	if p := c.customPartition; p != nil {
+		if os.Getenv(names.AWSServiceEnvVar(servicePackageName)) == "" && os.Getenv("AWS_ENDPOINT_URL") == "" {
+			if endpoint := p.Endpoint(servicePackageName, c.Region); endpoint != "" {
+				return endpoint
+			}
+		}
+	}
+
 	// Only continue if there is an SDK v1 package. SDK v2 supports envvars and config file
 	if names.ClientSDKV1(servicePackageName) {
 		endpoint = aws_sdkv2.ToString(c.awsConfig.BaseEndpoint)
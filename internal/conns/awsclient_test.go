@@ -6,6 +6,8 @@ package conns
 import (
 	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -122,6 +124,19 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.ec2.internal",
 		},
+		{
+			Name: "custom partition",
+			AWSClient: &AWSClient{
+				customPartition: &Partition{
+					DNSSuffix:                   "example.test",
+					EC2RegionalPrivateDNSSuffix: "{region}.compute.internal.example.test",
+				},
+				dnsSuffix: "example.test",
+				Region:    "xx-test-1",
+			},
+			IP:       "10.20.30.40",
+			Expected: "ip-10-20-30-40.xx-test-1.compute.internal.example.test",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -166,6 +181,19 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.compute-1.amazonaws.com",
 		},
+		{
+			Name: "custom partition",
+			AWSClient: &AWSClient{
+				customPartition: &Partition{
+					DNSSuffix:                  "example.test",
+					EC2RegionalPublicDNSSuffix: "{region}.compute",
+				},
+				dnsSuffix: "example.test",
+				Region:    "xx-test-1",
+			},
+			IP:       "10.20.30.40",
+			Expected: "ec2-10-20-30-40.xx-test-1.compute.example.test",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -181,3 +209,192 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientCloudFrontDistributionHostedZoneID(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				Partition: names.StandardPartitionID,
+			},
+			Expected: "Z2FDTNDATAQYW2",
+		},
+		{
+			Name: "AWS China",
+			AWSClient: &AWSClient{
+				Partition: names.ChinaPartitionID,
+			},
+			Expected: "Z3RFFRIM2A3IF5",
+		},
+		{
+			Name: "custom partition",
+			AWSClient: &AWSClient{
+				customPartition: &Partition{
+					CloudFrontDistributionHostedZoneID: "ZTESTZONE",
+				},
+				Partition: "aws-test",
+			},
+			Expected: "ZTESTZONE",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.AWSClient.CloudFrontDistributionHostedZoneID(ctx)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientReverseDNSPrefix(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Expected  string
+	}{
+		{
+			Name: "AWS Commercial",
+			AWSClient: &AWSClient{
+				dnsSuffix: "amazonaws.com",
+			},
+			Expected: "com.amazonaws",
+		},
+		{
+			Name: "custom partition",
+			AWSClient: &AWSClient{
+				customPartition: &Partition{
+					ReverseDNSPrefix: "test.example.reverse",
+				},
+				dnsSuffix: "example.test",
+			},
+			Expected: "test.example.reverse",
+		},
+		{
+			Name: "custom partition no prefix",
+			AWSClient: &AWSClient{
+				customPartition: &Partition{},
+				dnsSuffix:       "example.test",
+			},
+			Expected: "test.example",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.AWSClient.ReverseDNSPrefix(ctx)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAWSClientPartitionForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	metadata, err := ParsePartitionMetadata([]byte(`{
+  "partitions": [{
+    "id": "aws-test",
+    "dnsSuffix": "example.test",
+    "regions": {"xx-test-1": {"description": "Test (One)"}},
+    "regionRegex": "^xx\\-test\\-\\d+$"
+  }]
+}`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name              string
+		AWSClient         *AWSClient
+		Region            string
+		ExpectedOK        bool
+		ExpectedID        string
+		ExpectedDNSSuffix string
+	}{
+		{
+			Name:              "AWS Commercial",
+			AWSClient:         &AWSClient{},
+			Region:            "us-west-2", //lintignore:AWSAT003
+			ExpectedOK:        true,
+			ExpectedID:        names.StandardPartitionID,
+			ExpectedDNSSuffix: "amazonaws.com",
+		},
+		{
+			Name:              "AWS China",
+			AWSClient:         &AWSClient{partitionMetadata: metadata},
+			Region:            "cn-northwest-1", //lintignore:AWSAT003
+			ExpectedOK:        true,
+			ExpectedID:        names.ChinaPartitionID,
+			ExpectedDNSSuffix: "amazonaws.com.cn",
+		},
+		{
+			Name:              "custom partition",
+			AWSClient:         &AWSClient{partitionMetadata: metadata},
+			Region:            "xx-test-1",
+			ExpectedOK:        true,
+			ExpectedID:        "aws-test",
+			ExpectedDNSSuffix: "example.test",
+		},
+		{
+			Name:              "custom partition Region regex",
+			AWSClient:         &AWSClient{partitionMetadata: metadata},
+			Region:            "xx-test-2",
+			ExpectedOK:        true,
+			ExpectedID:        "aws-test",
+			ExpectedDNSSuffix: "example.test",
+		},
+		{
+			Name:      "no partition metadata",
+			AWSClient: &AWSClient{},
+			Region:    "xx-test-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := testCase.AWSClient.PartitionForRegion(ctx, testCase.Region)
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got.ID != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", got.ID, testCase.ExpectedID)
+			}
+
+			if got.DNSSuffix != testCase.ExpectedDNSSuffix {
+				t.Errorf("got DNS suffix %s, expected %s", got.DNSSuffix, testCase.ExpectedDNSSuffix)
+			}
+		})
+	}
+}
@@ -29,6 +29,9 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedOUPaths                 []string
+	AllowedRegions                 []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -44,12 +47,14 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PartitionMetadataFile          string
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceQuotasPreflight         bool
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -139,6 +144,21 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	var partitionMetadata *PartitionMetadata
+	if c.PartitionMetadataFile != "" {
+		v, err := LoadPartitionMetadata(c.PartitionMetadataFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		partitionMetadata = v
+	}
+
+	// Regions in custom partitions are not known to the AWS SDKs' embedded endpoints data,
+	// so IAM and STS endpoints must be resolved before any credentials are obtained.
+	if p, ok := partitionMetadata.PartitionForRegion(c.Region); ok {
+		setCustomPartitionEndpoints(&awsbaseConfig, p, c.Region)
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -159,13 +179,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
-	if !c.SkipRegionValidation {
+	customPartition, isCustomPartition := partitionMetadata.PartitionForRegion(cfg.Region)
+
+	if !c.SkipRegionValidation && !isCustomPartition {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isCustomPartition {
+		setCustomPartitionEndpoints(&awsbaseConfig, customPartition, c.Region)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -205,7 +235,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	dnsSuffix := "amazonaws.com"
-	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
+	if isCustomPartition {
+		dnsSuffix = customPartition.DNSSuffix
+		partition = customPartition.ID
+	} else if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
@@ -223,14 +256,50 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	if isCustomPartition {
+		client.customPartition = customPartition
+	}
+	client.partitionMetadata = partitionMetadata
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceQuotasPreflight = c.ServiceQuotasPreflight
 	client.stsRegion = c.STSRegion
 
+	if len(c.AllowedOrganizationIDs) > 0 || len(c.AllowedOUPaths) > 0 {
+		if c.SkipRequestingAccountId || accountID == "" {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"AWS Organization not verified",
+				"allowed_organization_ids and allowed_ou_paths are not verified when skip_requesting_account_id is set or the AWS account ID cannot be determined."))
+		} else {
+			v, err := findAccountOrganization(ctx, client.OrganizationsClient(ctx), accountID, len(c.AllowedOUPaths) > 0)
+
+			if err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "verifying AWS Organization for account (%s): %s", accountID, err)
+			}
+
+			if err := verifyOrganizationIDAllowed(v.organizationID, c.AllowedOrganizationIDs); err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if err := verifyOUPathAllowed(v.ouPath, c.AllowedOUPaths); err != nil {
+				return nil, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return client, diags
 }
 
+func setCustomPartitionEndpoints(awsbaseConfig *awsbase.Config, p *Partition, region string) {
+	if awsbaseConfig.IamEndpoint == "" {
+		awsbaseConfig.IamEndpoint = p.Endpoint(names.IAM, region)
+	}
+	if awsbaseConfig.StsEndpoint == "" {
+		awsbaseConfig.StsEndpoint = p.Endpoint(names.STS, region)
+	}
+}
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
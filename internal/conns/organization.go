@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// organizationsAPI is the subset of the AWS Organizations API used to verify an account's AWS Organization.
+type organizationsAPI interface {
+	DescribeOrganization(context.Context, *organizations.DescribeOrganizationInput, ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error)
+	ListParents(context.Context, *organizations.ListParentsInput, ...func(*organizations.Options)) (*organizations.ListParentsOutput, error)
+}
+
+// accountOrganization describes an AWS account's position in its AWS Organization.
+type accountOrganization struct {
+	organizationID string
+	// The account's parent OU path, in the format `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/`.
+	ouPath string
+}
+
+// accountOrganizations caches Organizations lookups, keyed by account ID,
+// as a provider may be configured many times during a single Terraform run.
+var accountOrganizations = struct {
+	sync.Mutex
+	cache map[string]*accountOrganization
+}{
+	cache: make(map[string]*accountOrganization),
+}
+
+// findAccountOrganization returns the (cached) AWS Organization details for the specified account.
+// The OU path is only looked up if requested, as ListParents may only be called from the management account
+// or a delegated administrator account.
+func findAccountOrganization(ctx context.Context, conn organizationsAPI, accountID string, ouPath bool) (*accountOrganization, error) {
+	accountOrganizations.Lock()
+	defer accountOrganizations.Unlock()
+
+	if v, ok := accountOrganizations.cache[accountID]; ok && (!ouPath || v.ouPath != "") {
+		return v, nil
+	}
+
+	output, err := conn.DescribeOrganization(ctx, &organizations.DescribeOrganizationInput{})
+
+	if errs.IsA[*awstypes.AccessDeniedException](err) {
+		return nil, fmt.Errorf("reading AWS Organization: access denied. allowed_organization_ids and allowed_ou_paths require the organizations:DescribeOrganization permission: %w", err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS Organization: %w", err)
+	}
+
+	v := &accountOrganization{
+		organizationID: aws.ToString(output.Organization.Id),
+	}
+
+	if ouPath {
+		// Walk up the organization tree from the account to the root.
+		var path []string
+		for childID := accountID; ; {
+			parent, err := findParent(ctx, conn, childID)
+
+			if errs.IsA[*awstypes.AccessDeniedException](err) {
+				return nil, fmt.Errorf("reading AWS Organizations parent of %s: access denied. allowed_ou_paths requires the organizations:ListParents permission, "+
+					"which AWS Organizations only allows from the management account or a delegated administrator account. "+
+					"Grant organizations:ListParents and delegate it to this account with an AWS Organizations resource-based delegation policy "+
+					"(for example, aws_organizations_resource_policy in the management account), or use allowed_organization_ids instead: %w", childID, err)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("reading AWS Organizations parent of %s: %w", childID, err)
+			}
+
+			path = append(path, aws.ToString(parent.Id))
+
+			if parent.Type == awstypes.ParentTypeRoot {
+				break
+			}
+
+			childID = aws.ToString(parent.Id)
+		}
+
+		path = append(path, v.organizationID)
+		slices.Reverse(path)
+
+		v.ouPath = strings.Join(path, "/") + "/"
+	}
+
+	accountOrganizations.cache[accountID] = v
+
+	return v, nil
+}
+
+func findParent(ctx context.Context, conn organizationsAPI, childID string) (*awstypes.Parent, error) {
+	output, err := conn.ListParents(ctx, &organizations.ListParentsInput{
+		ChildId: aws.String(childID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Each account or OU has exactly one parent.
+	if len(output.Parents) != 1 {
+		return nil, fmt.Errorf("expected 1 parent, got %d", len(output.Parents))
+	}
+
+	return &output.Parents[0], nil
+}
+
+// verifyRegionAllowed returns an error if the Region is not in the list of allowed Regions.
+func verifyRegionAllowed(region string, allowedRegions []string) error {
+	if len(allowedRegions) == 0 || slices.Contains(allowedRegions, region) {
+		return nil
+	}
+
+	return fmt.Errorf("AWS Region (%s) not allowed", region)
+}
+
+// verifyOrganizationIDAllowed returns an error if the AWS Organization ID is not in the list of allowed IDs.
+func verifyOrganizationIDAllowed(organizationID string, allowedOrganizationIDs []string) error {
+	if len(allowedOrganizationIDs) == 0 || slices.Contains(allowedOrganizationIDs, organizationID) {
+		return nil
+	}
+
+	return fmt.Errorf("AWS Organization ID (%s) not allowed", organizationID)
+}
+
+// verifyOUPathAllowed returns an error if the OU path is not equal to, or a descendant of, any of the allowed OU paths.
+func verifyOUPathAllowed(ouPath string, allowedOUPaths []string) error {
+	if len(allowedOUPaths) == 0 {
+		return nil
+	}
+
+	for _, v := range allowedOUPaths {
+		if !strings.HasSuffix(v, "/") {
+			v += "/"
+		}
+
+		if strings.HasPrefix(ouPath, v) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("AWS Organizations OU path (%s) not allowed", ouPath)
+}
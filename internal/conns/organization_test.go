@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+type mockOrganizationsClient struct {
+	organizationID string
+	parents        map[string]awstypes.Parent
+	parentsErr     error
+}
+
+func (m *mockOrganizationsClient) DescribeOrganization(context.Context, *organizations.DescribeOrganizationInput, ...func(*organizations.Options)) (*organizations.DescribeOrganizationOutput, error) {
+	return &organizations.DescribeOrganizationOutput{
+		Organization: &awstypes.Organization{
+			Id: aws.String(m.organizationID),
+		},
+	}, nil
+}
+
+func (m *mockOrganizationsClient) ListParents(_ context.Context, input *organizations.ListParentsInput, _ ...func(*organizations.Options)) (*organizations.ListParentsOutput, error) {
+	if m.parentsErr != nil {
+		return nil, m.parentsErr
+	}
+
+	output := &organizations.ListParentsOutput{}
+	if v, ok := m.parents[aws.ToString(input.ChildId)]; ok {
+		output.Parents = append(output.Parents, v)
+	}
+
+	return output, nil
+}
+
+func TestFindAccountOrganization(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		accountID      string
+		client         *mockOrganizationsClient
+		ouPath         bool
+		expectedOUPath string
+		expectedError  []string
+	}{
+		"no OU path": {
+			accountID: "111111111111",
+			client: &mockOrganizationsClient{
+				organizationID: "o-a1b2c3d4e5",
+				parentsErr:     &awstypes.AccessDeniedException{Message: aws.String("not authorized")},
+			},
+		},
+		"root": {
+			accountID: "222222222222",
+			client: &mockOrganizationsClient{
+				organizationID: "o-a1b2c3d4e5",
+				parents: map[string]awstypes.Parent{
+					"222222222222": {Id: aws.String("r-ab12"), Type: awstypes.ParentTypeRoot},
+				},
+			},
+			ouPath:         true,
+			expectedOUPath: "o-a1b2c3d4e5/r-ab12/",
+		},
+		"multi-level OU": {
+			accountID: "333333333333",
+			client: &mockOrganizationsClient{
+				organizationID: "o-a1b2c3d4e5",
+				parents: map[string]awstypes.Parent{
+					"333333333333":     {Id: aws.String("ou-ab12-33333333"), Type: awstypes.ParentTypeOrganizationalUnit},
+					"ou-ab12-33333333": {Id: aws.String("ou-ab12-22222222"), Type: awstypes.ParentTypeOrganizationalUnit},
+					"ou-ab12-22222222": {Id: aws.String("ou-ab12-11111111"), Type: awstypes.ParentTypeOrganizationalUnit},
+					"ou-ab12-11111111": {Id: aws.String("r-ab12"), Type: awstypes.ParentTypeRoot},
+				},
+			},
+			ouPath:         true,
+			expectedOUPath: "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/ou-ab12-33333333/",
+		},
+		"access denied": {
+			accountID: "444444444444",
+			client: &mockOrganizationsClient{
+				organizationID: "o-a1b2c3d4e5",
+				parentsErr:     &awstypes.AccessDeniedException{Message: aws.String("not authorized")},
+			},
+			ouPath:        true,
+			expectedError: []string{"access denied", "organizations:ListParents", "delegated administrator", "allowed_organization_ids"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findAccountOrganization(context.Background(), testCase.client, testCase.accountID, testCase.ouPath)
+
+			if len(testCase.expectedError) > 0 {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				for _, v := range testCase.expectedError {
+					if !strings.Contains(err.Error(), v) {
+						t.Errorf("error %q does not contain %q", err, v)
+					}
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := got.organizationID, testCase.client.organizationID; got != want {
+				t.Errorf("organizationID = %q, want %q", got, want)
+			}
+
+			if got, want := got.ouPath, testCase.expectedOUPath; got != want {
+				t.Errorf("ouPath = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region         string
+		allowedRegions []string
+		expectError    bool
+	}{
+		"no allowed regions": {
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+		"allowed": {
+			region:         "us-west-2",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		},
+		"not allowed": {
+			region:         "eu-west-1",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.region, testCase.allowedRegions)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestVerifyOrganizationIDAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		organizationID         string
+		allowedOrganizationIDs []string
+		expectError            bool
+	}{
+		"no allowed organization IDs": {
+			organizationID: "o-a1b2c3d4e5",
+		},
+		"allowed": {
+			organizationID:         "o-a1b2c3d4e5",
+			allowedOrganizationIDs: []string{"o-a1b2c3d4e5"},
+		},
+		"not allowed": {
+			organizationID:         "o-f6g7h8i9j0",
+			allowedOrganizationIDs: []string{"o-a1b2c3d4e5"},
+			expectError:            true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyOrganizationIDAllowed(testCase.organizationID, testCase.allowedOrganizationIDs)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestVerifyOUPathAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ouPath         string
+		allowedOUPaths []string
+		expectError    bool
+	}{
+		"no allowed OU paths": {
+			ouPath: "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/",
+		},
+		"same OU": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+		},
+		"same OU no trailing slash": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111"},
+		},
+		"descendant OU": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/ou-ab12-22222222/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+		},
+		"root": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/"},
+		},
+		"sibling OU": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-33333333/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			expectError:    true,
+		},
+		"OU ID prefix": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/ou-ab12-111111112/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111"},
+			expectError:    true,
+		},
+		"ancestor OU": {
+			ouPath:         "o-a1b2c3d4e5/r-ab12/",
+			allowedOUPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/"},
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyOUPathAllowed(testCase.ouPath, testCase.allowedOUPaths)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// PartitionMetadata represents AWS partition metadata loaded from a file.
+// It describes isolated and sovereign partitions that are not known to the AWS SDKs' embedded endpoints data.
+type PartitionMetadata struct {
+	Partitions []*Partition `json:"partitions"`
+}
+
+// Partition represents the metadata for a single AWS partition.
+// Template values may contain `{region}`, `{dnsSuffix}` and `{service}` placeholders.
+type Partition struct {
+	ID                                 string                     `json:"id"` // The ARN partition, e.g. "aws-iso-f"
+	Name                               string                     `json:"name"`
+	DNSSuffix                          string                     `json:"dnsSuffix"`
+	ReverseDNSPrefix                   string                     `json:"reverseDnsPrefix"`
+	RegionRegex                        string                     `json:"regionRegex"`
+	Regions                            map[string]PartitionRegion `json:"regions"`
+	CloudFrontDistributionHostedZoneID string                     `json:"cloudFrontDistributionHostedZoneId"`
+	GlobalAcceleratorHostedZoneID      string                     `json:"globalAcceleratorHostedZoneId"`
+	EC2RegionalPrivateDNSSuffix        string                     `json:"ec2RegionalPrivateDnsSuffix"` // Template
+	EC2RegionalPublicDNSSuffix         string                     `json:"ec2RegionalPublicDnsSuffix"`  // Template
+	Endpoints                          map[string]string          `json:"endpoints"`                   // Service package name to endpoint URL template
+
+	regionRegex *regexp.Regexp
+}
+
+// PartitionRegion represents the metadata for a single AWS Region in a partition.
+type PartitionRegion struct {
+	Description string `json:"description"`
+}
+
+// LoadPartitionMetadata reads and validates partition metadata from the specified JSON file.
+func LoadPartitionMetadata(filename string) (*PartitionMetadata, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading partition metadata file (%s): %w", filename, err)
+	}
+
+	metadata, err := ParsePartitionMetadata(b)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing partition metadata file (%s): %w", filename, err)
+	}
+
+	return metadata, nil
+}
+
+// ParsePartitionMetadata parses and validates JSON-encoded partition metadata.
+func ParsePartitionMetadata(b []byte) (*PartitionMetadata, error) {
+	var metadata PartitionMetadata
+
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	ids := make(map[string]struct{})
+
+	for i, p := range metadata.Partitions {
+		if p == nil {
+			errs = append(errs, fmt.Errorf("partitions[%d]: empty partition", i))
+			continue
+		}
+
+		if p.ID == "" {
+			errs = append(errs, fmt.Errorf("partitions[%d]: id is required", i))
+		} else if _, ok := ids[p.ID]; ok {
+			errs = append(errs, fmt.Errorf("partitions[%d]: duplicate id %q", i, p.ID))
+		} else {
+			ids[p.ID] = struct{}{}
+		}
+
+		if p.DNSSuffix == "" {
+			errs = append(errs, fmt.Errorf("partitions[%d]: dnsSuffix is required", i))
+		}
+
+		if len(p.Regions) == 0 && p.RegionRegex == "" {
+			errs = append(errs, fmt.Errorf("partitions[%d]: one of regions or regionRegex is required", i))
+		}
+
+		if p.RegionRegex != "" {
+			re, err := regexp.Compile(p.RegionRegex)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("partitions[%d]: regionRegex: %w", i, err))
+			}
+
+			p.regionRegex = re
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+// PartitionForRegion returns the partition containing the specified Region.
+// Regions listed explicitly take precedence over those matching a partition's Region regular expression.
+func (m *PartitionMetadata) PartitionForRegion(region string) (*Partition, bool) {
+	if m == nil || region == "" {
+		return nil, false
+	}
+
+	for _, p := range m.Partitions {
+		if _, ok := p.Regions[region]; ok {
+			return p, true
+		}
+	}
+
+	for _, p := range m.Partitions {
+		if p.regionRegex != nil && p.regionRegex.MatchString(region) {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// Endpoint returns the endpoint URL for the specified service package in the specified Region.
+// An empty string is returned if the partition has no endpoint template for the service.
+func (p *Partition) Endpoint(servicePackageName, region string) string {
+	if p == nil {
+		return ""
+	}
+
+	v, ok := p.Endpoints[servicePackageName]
+	if !ok {
+		return ""
+	}
+
+	return p.expand(v, servicePackageName, region)
+}
+
+func (p *Partition) expand(template, servicePackageName, region string) string {
+	return strings.NewReplacer(
+		"{dnsSuffix}", p.DNSSuffix,
+		"{region}", region,
+		"{service}", servicePackageName,
+	).Replace(template)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestParsePartitionMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		metadata    string
+		expectError bool
+	}{
+		"valid": {
+			metadata: `{
+  "partitions": [{
+    "id": "aws-test",
+    "dnsSuffix": "example.test",
+    "regions": {"xx-test-1": {"description": "Test 1"}}
+  }]
+}`,
+		},
+		"valid region regex": {
+			metadata: `{"partitions": [{"id": "aws-test", "dnsSuffix": "example.test", "regionRegex": "^xx\\-test\\-\\d+$"}]}`,
+		},
+		"invalid JSON": {
+			metadata:    `{"partitions": [`,
+			expectError: true,
+		},
+		"no id": {
+			metadata:    `{"partitions": [{"dnsSuffix": "example.test", "regions": {"xx-test-1": {}}}]}`,
+			expectError: true,
+		},
+		"no DNS suffix": {
+			metadata:    `{"partitions": [{"id": "aws-test", "regions": {"xx-test-1": {}}}]}`,
+			expectError: true,
+		},
+		"no regions": {
+			metadata:    `{"partitions": [{"id": "aws-test", "dnsSuffix": "example.test"}]}`,
+			expectError: true,
+		},
+		"invalid region regex": {
+			metadata:    `{"partitions": [{"id": "aws-test", "dnsSuffix": "example.test", "regionRegex": "("}]}`,
+			expectError: true,
+		},
+		"duplicate id": {
+			metadata: `{"partitions": [
+  {"id": "aws-test", "dnsSuffix": "example.test", "regions": {"xx-test-1": {}}},
+  {"id": "aws-test", "dnsSuffix": "example.test", "regions": {"xx-test-2": {}}}
+]}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParsePartitionMetadata([]byte(testCase.metadata))
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ParsePartitionMetadata error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestPartitionMetadataPartitionForRegion(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := ParsePartitionMetadata([]byte(`{
+  "partitions": [{
+    "id": "aws-test",
+    "dnsSuffix": "example.test",
+    "regions": {"xx-test-1": {"description": "Test 1"}}
+  }, {
+    "id": "aws-test-b",
+    "dnsSuffix": "b.example.test",
+    "regionRegex": "^xx\\-test\\-\\d+$"
+  }]
+}`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		region      string
+		expectedID  string
+		expectFound bool
+	}{
+		"explicit region": {
+			region:      "xx-test-1",
+			expectedID:  "aws-test",
+			expectFound: true,
+		},
+		"region regex": {
+			region:      "xx-test-2",
+			expectedID:  "aws-test-b",
+			expectFound: true,
+		},
+		"unknown region": {
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+		"empty region": {},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, ok := metadata.PartitionForRegion(testCase.region)
+
+			if got, want := ok, testCase.expectFound; got != want {
+				t.Fatalf("PartitionForRegion found = %t, want %t", got, want)
+			}
+
+			if ok {
+				if got, want := p.ID, testCase.expectedID; got != want {
+					t.Errorf("PartitionForRegion ID = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+
+	var nilMetadata *PartitionMetadata
+	if _, ok := nilMetadata.PartitionForRegion("xx-test-1"); ok {
+		t.Errorf("PartitionForRegion on nil metadata found partition")
+	}
+}
+
+func TestPartitionEndpoint(t *testing.T) {
+	t.Parallel()
+
+	p := &Partition{
+		DNSSuffix: "example.test",
+		Endpoints: map[string]string{
+			"ec2": "https://ec2.{region}.{dnsSuffix}",
+			"sts": "https://{service}.{region}.{dnsSuffix}",
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		expected           string
+	}{
+		"ec2": {
+			servicePackageName: "ec2",
+			expected:           "https://ec2.xx-test-1.example.test",
+		},
+		"sts": {
+			servicePackageName: "sts",
+			expected:           "https://sts.xx-test-1.example.test",
+		},
+		"no template": {
+			servicePackageName: "s3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := p.Endpoint(testCase.servicePackageName, "xx-test-1"), testCase.expected; got != want {
+				t.Errorf("Endpoint = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -109,6 +110,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	QuotaServiceCode        string
+	QuotaCode               string
+	QuotaGlobal             bool
+	QuotaUnitsAttribute     string
 }
 
 type ServiceDatum struct {
@@ -209,6 +214,38 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Quota" {
+			args := common.ParseArgs(m[3])
+
+			if d.QuotaCode != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple Quota annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["serviceCode"]; ok {
+				d.QuotaServiceCode = attr
+			}
+
+			if attr, ok := args.Keyword["quotaCode"]; ok {
+				d.QuotaCode = attr
+			}
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Quota global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.QuotaGlobal = b
+				}
+			}
+
+			if attr, ok := args.Keyword["unitsAttribute"]; ok {
+				d.QuotaUnitsAttribute = attr
+			}
+
+			if d.QuotaServiceCode == "" || d.QuotaCode == "" {
+				v.errs = append(v.errs, fmt.Errorf("Quota annotation requires serviceCode and quotaCode: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -262,7 +299,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Quota", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
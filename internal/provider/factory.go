@@ -34,5 +34,13 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	quotas, err := serviceQuotaResources(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return newPreflightProviderServer(muxServer.ProviderServer(), primary.Meta, quotas)
+	}, primary, nil
 }
@@ -56,6 +56,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_organization_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Organization IDs.",
+			},
+			"allowed_ou_paths": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Organizations OU paths. The AWS account must be in one of the OUs or their descendants.",
+			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "List of allowed AWS Regions.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -92,6 +107,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"partition_metadata_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "File containing AWS partition metadata (Regions, DNS suffixes, hosted zone IDs and service endpoint templates) for partitions that are not known to the AWS SDKs.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -118,6 +137,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_quotas_preflight": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Warn at plan time when planned resource creates would exceed applied Service Quotas values.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -300,6 +323,15 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	v := p.Primary.Meta()
 	response.DataSourceData = v
 	response.ResourceData = v
+
+	// If any provider configuration is unknown, defer all resources and data sources.
+	if request.ClientCapabilities.DeferralAllowed && !request.Config.Raw.IsFullyKnown() {
+		tflog.Info(ctx, "Provider configuration is unknown, deferring")
+
+		response.Deferred = &provider.Deferred{
+			Reason: provider.DeferredReasonProviderConfigUnknown,
+		}
+	}
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicequotas "github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// preflightProviderServer is a terraform-plugin-go provider server that, when the
+// `service_quotas_preflight` provider configuration argument is set, warns at plan
+// time if planned resource creates would exceed applied Service Quotas values.
+type preflightProviderServer struct {
+	tfprotov5.ProviderServer
+
+	meta      func() any
+	preflight *tfservicequotas.Preflight
+	quotas    map[string]*types.ServicePackageResourceQuota
+
+	schemasOnce sync.Once
+	schemas     map[string]*tfprotov5.Schema
+	schemasErr  error
+}
+
+func newPreflightProviderServer(server tfprotov5.ProviderServer, meta func() any, quotas map[string]*types.ServicePackageResourceQuota) tfprotov5.ProviderServer {
+	return &preflightProviderServer{
+		ProviderServer: server,
+		meta:           meta,
+		preflight:      tfservicequotas.NewPreflight(),
+		quotas:         quotas,
+	}
+}
+
+func (s *preflightProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	quota, ok := s.quotas[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	meta, ok := s.meta().(*conns.AWSClient)
+	if !ok || !meta.ServiceQuotasPreflight(ctx) {
+		return response, nil
+	}
+
+	if hasProtoV5ErrorDiagnostics(response.Diagnostics) || !isProtoV5Create(request.PriorState, response.PlannedState) {
+		return response, nil
+	}
+
+	units := float64(1)
+	if quota.UnitsAttribute != "" {
+		units, err = s.plannedUnits(ctx, request.TypeName, quota.UnitsAttribute, response.PlannedState)
+
+		if err != nil {
+			tflog.Warn(ctx, "Service Quotas preflight check", map[string]any{
+				"type_name": request.TypeName,
+				"error":     err.Error(),
+			})
+
+			return response, nil
+		}
+	}
+
+	result, err := s.preflight.PlanCreate(ctx, meta, quota, units)
+
+	if err != nil {
+		tflog.Warn(ctx, "Service Quotas preflight check", map[string]any{
+			"type_name": request.TypeName,
+			"error":     err.Error(),
+		})
+
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Unable to check Service Quotas",
+			Detail:   err.Error(),
+		})
+
+		return response, nil
+	}
+
+	switch {
+	case result.Exceeded:
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Planned resources may exceed Service Quotas",
+			Detail:   fmt.Sprintf("%s\n\nResource %s is likely to fail creation with a quota or limit exceeded error.", result.Message, request.TypeName),
+		})
+	case result.UsageUnknown:
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Service Quotas usage unknown",
+			Detail:   result.Message,
+		})
+	}
+
+	return response, nil
+}
+
+// plannedUnits returns the planned value of a resource's top-level number attribute.
+// Null or unknown values count as zero units.
+func (s *preflightProviderServer) plannedUnits(ctx context.Context, typeName, attributeName string, plannedState *tfprotov5.DynamicValue) (float64, error) {
+	s.schemasOnce.Do(func() {
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			s.schemasErr = err
+			return
+		}
+
+		s.schemas = response.ResourceSchemas
+	})
+
+	if s.schemasErr != nil {
+		return 0, fmt.Errorf("reading provider schema: %w", s.schemasErr)
+	}
+
+	schema, ok := s.schemas[typeName]
+	if !ok {
+		return 0, fmt.Errorf("no schema for resource %s", typeName)
+	}
+
+	return dynamicValueNumberAttribute(plannedState, schema.ValueType(), attributeName)
+}
+
+func (s *preflightProviderServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if v, ok := s.ProviderServer.(tfprotov5.ResourceServerWithMoveResourceState); ok {
+		return v.MoveResourceState(ctx, request)
+	}
+
+	return nil, fmt.Errorf("MoveResourceState not implemented")
+}
+
+// serviceQuotaResources returns the resource type names that have declared Service Quotas metadata.
+func serviceQuotaResources(ctx context.Context) (map[string]*types.ServicePackageResourceQuota, error) {
+	quotas := make(map[string]*types.ServicePackageResourceQuota)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Quota != nil {
+				quotas[v.TypeName] = v.Quota
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.Quota == nil {
+				continue
+			}
+
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("creating resource: %w", err)
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+			quotas[metadataResponse.TypeName] = v.Quota
+		}
+	}
+
+	return quotas, nil
+}
+
+// dynamicValueNumberAttribute returns the value of a top-level number attribute of an object.
+// Null or unknown values are returned as zero.
+func dynamicValueNumberAttribute(dv *tfprotov5.DynamicValue, typ tftypes.Type, attributeName string) (float64, error) {
+	value, err := dv.Unmarshal(typ)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return 0, err
+	}
+
+	attribute, ok := attributes[attributeName]
+	if !ok {
+		return 0, fmt.Errorf("no attribute %q", attributeName)
+	}
+
+	if !attribute.IsKnown() || attribute.IsNull() {
+		return 0, nil
+	}
+
+	var number big.Float
+	if err := attribute.As(&number); err != nil {
+		return 0, err
+	}
+
+	f, _ := number.Float64()
+
+	return f, nil
+}
+
+func hasProtoV5ErrorDiagnostics(diags []*tfprotov5.Diagnostic) bool {
+	for _, v := range diags {
+		if v != nil && v.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isProtoV5Create returns whether a planned resource change is a create.
+func isProtoV5Create(priorState, plannedState *tfprotov5.DynamicValue) bool {
+	if plannedState == nil {
+		return false
+	}
+
+	if null, err := plannedState.IsNull(); err != nil || null {
+		return false
+	}
+
+	if priorState == nil {
+		return true
+	}
+
+	null, err := priorState.IsNull()
+
+	return err == nil && null
+}
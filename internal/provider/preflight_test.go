@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServiceQuotaResources(t *testing.T) {
+	t.Parallel()
+
+	quotas, err := serviceQuotaResources(context.Background())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, want := range map[string]string{
+		"aws_eip":             "L-0263D0A3",
+		"aws_iam_role":        "L-FE177D64",
+		"aws_lambda_function": "L-B99A9384",
+		"aws_lambda_provisioned_concurrency_config": "L-B99A9384",
+		"aws_vpc": "L-F678F1CE",
+	} {
+		quota, ok := quotas[typeName]
+
+		if !ok {
+			t.Errorf("no Service Quotas metadata for %s", typeName)
+			continue
+		}
+
+		if got := quota.QuotaCode; got != want {
+			t.Errorf("%s quota code = %q, want %q", typeName, got, want)
+		}
+	}
+
+	if !quotas["aws_iam_role"].Global {
+		t.Errorf("aws_iam_role Service Quotas metadata is not global")
+	}
+
+	if got, want := quotas["aws_lambda_function"].UnitsAttribute, "reserved_concurrent_executions"; got != want {
+		t.Errorf("aws_lambda_function units attribute = %q, want %q", got, want)
+	}
+
+	if _, ok := quotas["aws_vpc_ipv4_cidr_block_association"]; ok {
+		t.Errorf("unexpected Service Quotas metadata for aws_vpc_ipv4_cidr_block_association")
+	}
+}
+
+func TestIsProtoV5Create(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+
+	null, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	known, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "test"),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		priorState   *tfprotov5.DynamicValue
+		plannedState *tfprotov5.DynamicValue
+		expected     bool
+	}{
+		"create": {
+			priorState:   &null,
+			plannedState: &known,
+			expected:     true,
+		},
+		"create no prior state": {
+			plannedState: &known,
+			expected:     true,
+		},
+		"update": {
+			priorState:   &known,
+			plannedState: &known,
+		},
+		"destroy": {
+			priorState:   &known,
+			plannedState: &null,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isProtoV5Create(testCase.priorState, testCase.plannedState), testCase.expected; got != want {
+				t.Errorf("isProtoV5Create = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDynamicValueNumberAttribute(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":    tftypes.String,
+		"units": tftypes.Number,
+	}}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		attributeName string
+		expected      float64
+		expectError   bool
+	}{
+		"known": {
+			value: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "test"),
+				"units": tftypes.NewValue(tftypes.Number, 42),
+			}),
+			attributeName: "units",
+			expected:      42,
+		},
+		"null": {
+			value: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "test"),
+				"units": tftypes.NewValue(tftypes.Number, nil),
+			}),
+			attributeName: "units",
+		},
+		"unknown": {
+			value: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "test"),
+				"units": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+			attributeName: "units",
+		},
+		"no attribute": {
+			value: tftypes.NewValue(typ, map[string]tftypes.Value{
+				"id":    tftypes.NewValue(tftypes.String, "test"),
+				"units": tftypes.NewValue(tftypes.Number, 42),
+			}),
+			attributeName: "count",
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dv, err := tfprotov5.NewDynamicValue(typ, testCase.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := dynamicValueNumberAttribute(&dv, typ, testCase.attributeName)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("dynamicValueNumberAttribute = %g, want %g", got, want)
+			}
+		})
+	}
+}
@@ -31,7 +31,7 @@ import (
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
-// The provider instance is fully configured once the `ConfigureContextFunc` has been called.
+// The provider instance is fully configured once the `ConfigureProvider` function has been called.
 func New(ctx context.Context) (*schema.Provider, error) {
 	provider := &schema.Provider{
 		// This schema must match exactly the Terraform Protocol v6 (Terraform Plugin Framework) provider's schema.
@@ -49,6 +49,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Organization IDs.",
+			},
+			"allowed_ou_paths": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Organizations OU paths. The AWS account must be in one of the OUs or their descendants.",
+			},
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of allowed AWS Regions.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -146,6 +164,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"partition_metadata_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File containing AWS partition metadata (Regions, DNS suffixes, hosted zone IDs and service endpoint templates) " +
+					"for partitions that are not known to the AWS SDKs.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +209,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_quotas_preflight": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Warn at plan time when planned resource creates would exceed applied Service Quotas values.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -258,8 +287,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
-	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+	provider.ConfigureProvider = func(ctx context.Context, request schema.ConfigureProviderRequest, response *schema.ConfigureProviderResponse) {
+		// If any provider configuration is unknown, for example `region` or `assume_role.role_arn`
+		// is set from another resource's output, defer all resources and data sources.
+		if request.DeferralAllowed && !request.ResourceData.GetRawConfig().IsWhollyKnown() {
+			tflog.Info(ctx, "Provider configuration is unknown, deferring")
+
+			response.Meta = provider.Meta()
+			response.Deferred = &schema.Deferred{
+				Reason: schema.DeferredReasonProviderConfigUnknown,
+			}
+
+			return
+		}
+
+		response.Meta, response.Diagnostics = configure(ctx, provider, request.ResourceData)
 	}
 
 	var errs []error
@@ -450,7 +492,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureContextFunc,
+	// It will be overwritten by the result of the call to ConfigureProvider,
 	// but can be used pre-configuration by other (non-primary) provider servers.
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
@@ -483,10 +525,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PartitionMetadataFile:          d.Get("partition_metadata_file").(string),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		ServiceQuotasPreflight:         d.Get("service_quotas_preflight").(bool),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
@@ -514,6 +558,18 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_ou_paths"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOUPaths = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role configuration set", map[string]any{
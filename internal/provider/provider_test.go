@@ -11,7 +11,10 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -32,6 +35,71 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureDeferred(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := schema.NewGRPCProviderServer(p)
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typ := schemaResponse.Provider.ValueType()
+	attrs := make(map[string]tftypes.Value)
+	for k, v := range typ.(tftypes.Object).AttributeTypes {
+		attrs[k] = tftypes.NewValue(v, nil)
+	}
+	attrs["region"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, attrs))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configureResponse, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		ClientCapabilities: &tfprotov5.ConfigureProviderClientCapabilities{
+			DeferralAllowed: true,
+		},
+		Config: &config,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range configureResponse.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	readResponse, err := server.ReadDataSource(ctx, &tfprotov5.ReadDataSourceRequest{
+		TypeName: "aws_iam_policy_document",
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if readResponse.Deferred == nil {
+		t.Fatal("expected deferred response")
+	}
+
+	if got, want := readResponse.Deferred.Reason, tfprotov5.DeferredReasonProviderConfigUnknown; got != want {
+		t.Errorf("deferred reason: got %s, want %s", got, want)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appfabric"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="App Authorization Connection")
+func newAppAuthorizationConnectionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appAuthorizationConnectionResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type appAuthorizationConnectionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[appAuthorizationConnectionResourceModel]
+	framework.WithNoOpDelete
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*appAuthorizationConnectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appfabric_app_authorization_connection"
+}
+
+func (r *appAuthorizationConnectionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_authorization_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_bundle_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"tenant": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tenantModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[tenantModel](ctx),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_request": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[authRequestModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Required: true,
+						},
+						"redirect_uri": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *appAuthorizationConnectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appAuthorizationConnectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	input := &appfabric.ConnectAppAuthorizationInput{
+		AppAuthorizationIdentifier: fwflex.StringFromFramework(ctx, data.AppAuthorizationARN),
+		AppBundleIdentifier:        fwflex.StringFromFramework(ctx, data.AppBundleARN),
+	}
+
+	if !data.AuthRequest.IsNull() {
+		authRequestData, diags := data.AuthRequest.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.AuthRequest = &awstypes.AuthRequest{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, authRequestData, input.AuthRequest)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_, err := conn.ConnectAppAuthorization(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating AppFabric App Authorization Connection", err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	appAuthorization, err := waitAppAuthorizationConnected(ctx, conn, data.AppAuthorizationARN.ValueString(), data.AppBundleARN.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for AppFabric App Authorization Connection (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.App = fwflex.StringToFramework(ctx, appAuthorization.App)
+	data.Tenant = flattenTenant(ctx, appAuthorization.Tenant)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *appAuthorizationConnectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appAuthorizationConnectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	output, err := findAppAuthorizationByTwoPartKey(ctx, conn, data.AppAuthorizationARN.ValueString(), data.AppBundleARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFabric App Authorization Connection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.App = fwflex.StringToFramework(ctx, output.App)
+	data.Tenant = flattenTenant(ctx, output.Tenant)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func statusAppAuthorization(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAppAuthorizationByTwoPartKey(ctx, conn, appAuthorizationARN, appBundleARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppAuthorizationConnected(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleARN string, timeout time.Duration) (*awstypes.AppAuthorization, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppAuthorizationStatusPendingConnect),
+		Target:  enum.Slice(awstypes.AppAuthorizationStatusConnected),
+		Refresh: statusAppAuthorization(ctx, conn, appAuthorizationARN, appBundleARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppAuthorization); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type appAuthorizationConnectionResourceModel struct {
+	App                 types.String                                      `tfsdk:"app"`
+	AppAuthorizationARN fwtypes.ARN                                       `tfsdk:"app_authorization_arn"`
+	AppBundleARN        fwtypes.ARN                                       `tfsdk:"app_bundle_arn"`
+	AuthRequest         fwtypes.ListNestedObjectValueOf[authRequestModel] `tfsdk:"auth_request"`
+	ID                  types.String                                      `tfsdk:"id"`
+	Tenant              fwtypes.ListNestedObjectValueOf[tenantModel]      `tfsdk:"tenant"`
+	Timeouts            timeouts.Value                                    `tfsdk:"timeouts"`
+}
+
+const (
+	appAuthorizationConnectionResourceIDPartCount = 2
+)
+
+func (data *appAuthorizationConnectionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), appAuthorizationConnectionResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.AppAuthorizationARN = fwtypes.ARNValue(parts[0])
+	data.AppBundleARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (data *appAuthorizationConnectionResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AppAuthorizationARN.ValueString(), data.AppBundleARN.ValueString()}, appAuthorizationConnectionResourceIDPartCount, false)))
+}
+
+type authRequestModel struct {
+	Code        types.String `tfsdk:"code"`
+	RedirectURI types.String `tfsdk:"redirect_uri"`
+}
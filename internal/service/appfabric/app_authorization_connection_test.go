@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAppAuthorizationConnection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var appauthorization awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization_connection.test"
+	appAuthorizationResourceName := "aws_appfabric_app_authorization.test"
+	appBundleResourceName := "aws_appfabric_app_bundle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.APNortheast1RegionID, names.EUWest1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConnectionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAppAuthorizationConnectionExists(ctx, resourceName, &appauthorization),
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_authorization_arn", appAuthorizationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", appBundleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auth_request.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tenant.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_display_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_identifier", "test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_request"},
+			},
+		},
+	})
+}
+
+func testAccAppAuthorizationConnection_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var appauthorization awstypes.AppAuthorization
+	resourceName := "aws_appfabric_app_authorization_connection.test"
+	appAuthorizationResourceName := "aws_appfabric_app_authorization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.APNortheast1RegionID, names.EUWest1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConnectionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppAuthorizationConnectionExists(ctx, resourceName, &appauthorization),
+					// Deleting the connection is a no-op, so remove the underlying App Authorization instead.
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceAppAuthorization, appAuthorizationResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAppAuthorizationConnectionExists(ctx context.Context, n string, v *awstypes.AppAuthorization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		output, err := tfappfabric.FindAppAuthorizationByTwoPartKey(ctx, conn, rs.Primary.Attributes["app_authorization_arn"], rs.Primary.Attributes["app_bundle_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		if output.Status != awstypes.AppAuthorizationStatusConnected {
+			return fmt.Errorf("AppFabric App Authorization %s not connected: %s", rs.Primary.ID, output.Status)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccAppAuthorizationConnectionConfig_basic = `
+resource "aws_appfabric_app_bundle" "test" {}
+
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = "ApiExampleKey"
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = "test"
+  }
+}
+
+resource "aws_appfabric_app_authorization_connection" "test" {
+  app_authorization_arn = aws_appfabric_app_authorization.test.arn
+  app_bundle_arn        = aws_appfabric_app_bundle.test.arn
+}
+`
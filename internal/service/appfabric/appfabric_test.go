@@ -17,12 +17,28 @@ func TestAccAppFabric_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"AppAuthorization": {
+			acctest.CtBasic:      testAccAppAuthorization_basic,
+			acctest.CtDisappears: testAccAppAuthorization_disappears,
+		},
+		"AppAuthorizationConnection": {
+			acctest.CtBasic:      testAccAppAuthorizationConnection_basic,
+			acctest.CtDisappears: testAccAppAuthorizationConnection_disappears,
+		},
 		"AppBundle": {
 			acctest.CtBasic:      testAccAppBundle_basic,
 			acctest.CtDisappears: testAccAppBundle_disappears,
 			"cmk":                testAccAppBundle_cmk,
 			"tags":               testAccAppBundle_tags,
 		},
+		"Ingestion": {
+			acctest.CtBasic:      testAccIngestion_basic,
+			acctest.CtDisappears: testAccIngestion_disappears,
+		},
+		"IngestionDestination": {
+			acctest.CtBasic:      testAccIngestionDestination_basic,
+			acctest.CtDisappears: testAccIngestionDestination_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, serializeDelay)
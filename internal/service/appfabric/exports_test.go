@@ -5,7 +5,14 @@ package appfabric
 
 // Exports for use in tests only.
 var (
-	ResourceAppBundle = newAppBundleResource
+	ResourceAppAuthorization           = newAppAuthorizationResource
+	ResourceAppAuthorizationConnection = newAppAuthorizationConnectionResource
+	ResourceAppBundle                  = newAppBundleResource
+	ResourceIngestion                  = newIngestionResource
+	ResourceIngestionDestination       = newIngestionDestinationResource
 
-	FindAppBundleByID = findAppBundleByID
+	FindAppAuthorizationByTwoPartKey       = findAppAuthorizationByTwoPartKey
+	FindAppBundleByID                      = findAppBundleByID
+	FindIngestionByTwoPartKey              = findIngestionByTwoPartKey
+	FindIngestionDestinationByThreePartKey = findIngestionDestinationByThreePartKey
 )
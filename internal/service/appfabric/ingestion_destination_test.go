@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappfabric "github.com/hashicorp/terraform-provider-aws/internal/service/appfabric"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccIngestionDestination_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ingestiondestination awstypes.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.APNortheast1RegionID, names.EUWest1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "AuditLog"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &ingestiondestination),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", "aws_appfabric_app_bundle.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.firehose_stream.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "AuditLog"),
+					resource.TestCheckResourceAttrPair(resourceName, "ingestion_arn", "aws_appfabric_ingestion.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "processing_configuration.0.audit_log.0.schema", "raw"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "AuditLogUpdated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &ingestiondestination),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.0.audit_log.0.destination.0.s3_bucket.0.prefix", "AuditLogUpdated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIngestionDestination_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ingestiondestination awstypes.IngestionDestination
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appfabric_ingestion_destination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID, names.APNortheast1RegionID, names.EUWest1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionDestinationConfig_basic(rName, "AuditLog"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionDestinationExists(ctx, resourceName, &ingestiondestination),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappfabric.ResourceIngestionDestination, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionDestinationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appfabric_ingestion_destination" {
+				continue
+			}
+
+			_, err := tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["ingestion_arn"], rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppFabric Ingestion Destination %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionDestinationExists(ctx context.Context, n string, v *awstypes.IngestionDestination) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppFabricClient(ctx)
+
+		output, err := tfappfabric.FindIngestionDestinationByThreePartKey(ctx, conn, rs.Primary.Attributes["app_bundle_arn"], rs.Primary.Attributes["ingestion_arn"], rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIngestionDestinationConfig_basic(rName, prefix string) string {
+	return acctest.ConfigCompose(testAccIngestionConfig_basic, fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_appfabric_ingestion_destination" "test" {
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  ingestion_arn  = aws_appfabric_ingestion.test.arn
+
+  processing_configuration {
+    audit_log {
+      format = "json"
+      schema = "raw"
+    }
+  }
+
+  destination_configuration {
+    audit_log {
+      destination {
+        s3_bucket {
+          bucket_name = aws_s3_bucket.test.bucket
+          prefix      = %[2]q
+        }
+      }
+    }
+  }
+}
+`, rName, prefix))
+}
@@ -174,6 +174,37 @@ func TestAccBedrockAgentAgent_tags(t *testing.T) {
 	})
 }
 
+func TestAccBedrockAgentAgent_guardrail(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent.test"
+	guardrailResourceName := "aws_bedrock_guardrail.test"
+	var v awstypes.Agent
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentConfig_guardrail(rName, "anthropic.claude-v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_configuration.0.guardrail_identifier", guardrailResourceName, "guardrail_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_configuration.0.guardrail_version", "aws_bedrock_guardrail_version.test", names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAgentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
@@ -326,6 +357,38 @@ resource "aws_bedrockagent_agent" "test" {
 `, rName, model, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccAgentConfig_guardrail(rName, model string) string {
+	return acctest.ConfigCompose(testAccAgent_base(rName, model), fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "Sorry, I cannot answer that."
+  blocked_outputs_messaging = "Sorry, I cannot answer that."
+
+  word_policy_config {
+    managed_word_lists_config {
+      type = "PROFANITY"
+    }
+  }
+}
+
+resource "aws_bedrock_guardrail_version" "test" {
+  guardrail_arn = aws_bedrock_guardrail.test.guardrail_arn
+}
+
+resource "aws_bedrockagent_agent" "test" {
+  agent_name              = %[1]q
+  agent_resource_role_arn = aws_iam_role.test_agent.arn
+  instruction             = file("${path.module}/test-fixtures/instruction.txt")
+  foundation_model        = %[2]q
+
+  guardrail_configuration {
+    guardrail_identifier = aws_bedrock_guardrail.test.guardrail_id
+    guardrail_version    = aws_bedrock_guardrail_version.test.version
+  }
+}
+`, rName, model))
+}
+
 func testAccAgentConfig_full(rName, model, desc string) string {
 	return acctest.ConfigCompose(testAccAgent_base(rName, model), fmt.Sprintf(`
 resource "aws_bedrockagent_agent" "test" {
@@ -10,12 +10,22 @@ var (
 	ResourceAgentAlias                    = newAgentAliasResource
 	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
 	ResourceDataSource                    = newDataSourceResource
+	ResourceFlow                          = newFlowResource
+	ResourceFlowAlias                     = newFlowAliasResource
+	ResourceFlowVersion                   = newFlowVersionResource
 	ResourceKnowledgeBase                 = newKnowledgeBaseResource
+	ResourcePrompt                        = newPromptResource
+	ResourcePromptVersion                 = newPromptVersionResource
 
 	FindAgentByID                                  = findAgentByID
 	FindAgentActionGroupByThreePartKey             = findAgentActionGroupByThreePartKey
 	FindAgentAliasByTwoPartKey                     = findAgentAliasByTwoPartKey
 	FindAgentKnowledgeBaseAssociationByThreePartID = findAgentKnowledgeBaseAssociationByThreePartKey
 	FindDataSourceByTwoPartKey                     = findDataSourceByTwoPartKey
+	FindFlowAliasByTwoPartKey                      = findFlowAliasByTwoPartKey
+	FindFlowByID                                   = findFlowByID
+	FindFlowVersionByTwoPartKey                    = findFlowVersionByTwoPartKey
 	FindKnowledgeBaseByID                          = findKnowledgeBaseByID
+	FindPromptByID                                 = findPromptByID
+	FindPromptVersionByTwoPartKey                  = findPromptVersionByTwoPartKey
 )
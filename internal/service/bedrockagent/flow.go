@@ -0,0 +1,1465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": flowConnectionBlock(ctx),
+						"node":       flowNodeBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func flowConnectionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrSource: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrTarget: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"conditional": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("conditional"),
+										path.MatchRelative().AtParent().AtName("data"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrCondition: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"data": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"source_output": schema.StringAttribute{
+											Required: true,
+										},
+										"target_input": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flowNodeBlock(ctx context.Context) schema.ListNestedBlock {
+	emptyNodeConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[emptyFlowNodeConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{},
+	}
+	s3ServiceConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeServiceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"s3": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrBucketName: schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+					Required:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrConfiguration: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"agent": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("agent"),
+										path.MatchRelative().AtParent().AtName("collector"),
+										path.MatchRelative().AtParent().AtName(names.AttrCondition),
+										path.MatchRelative().AtParent().AtName("input"),
+										path.MatchRelative().AtParent().AtName("iterator"),
+										path.MatchRelative().AtParent().AtName("knowledge_base"),
+										path.MatchRelative().AtParent().AtName("lambda_function"),
+										path.MatchRelative().AtParent().AtName("lex"),
+										path.MatchRelative().AtParent().AtName("output"),
+										path.MatchRelative().AtParent().AtName("prompt"),
+										path.MatchRelative().AtParent().AtName("retrieval"),
+										path.MatchRelative().AtParent().AtName("storage"),
+									),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"agent_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"collector": emptyNodeConfigurationBlock,
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										names.AttrCondition: schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeBetween(1, 5),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrExpression: schema.StringAttribute{
+														Optional: true,
+													},
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"input":    emptyNodeConfigurationBlock,
+							"iterator": emptyNodeConfigurationBlock,
+							"knowledge_base": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"knowledge_base_id": schema.StringAttribute{
+											Required: true,
+										},
+										"model_id": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+							"lambda_function": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"lambda_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+									},
+								},
+							},
+							"lex": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bot_alias_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
+										},
+										"locale_id": schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+							"output": emptyNodeConfigurationBlock,
+							"prompt": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"source_configuration": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Blocks: map[string]schema.Block{
+													"inline": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+															listvalidator.ExactlyOneOf(
+																path.MatchRelative().AtParent().AtName("inline"),
+																path.MatchRelative().AtParent().AtName("resource"),
+															),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"model_id": schema.StringAttribute{
+																	Required: true,
+																},
+																"template_type": schema.StringAttribute{
+																	CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+																	Required:   true,
+																},
+															},
+															Blocks: map[string]schema.Block{
+																"inference_configuration": promptInferenceConfigurationBlock(ctx),
+																"template_configuration":  promptTemplateConfigurationBlock(ctx),
+															},
+														},
+													},
+													"resource": schema.ListNestedBlock{
+														CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
+														NestedObject: schema.NestedBlockObject{
+															Attributes: map[string]schema.Attribute{
+																"prompt_arn": schema.StringAttribute{
+																	CustomType: fwtypes.ARNType,
+																	Required:   true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"retrieval": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[serviceFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": s3ServiceConfigurationBlock,
+									},
+								},
+							},
+							"storage": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[serviceFlowNodeConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"service_configuration": s3ServiceConfigurationBlock,
+									},
+								},
+							},
+						},
+					},
+				},
+				"input": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrExpression: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+				"output": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrName: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, flowFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	definition, diags := expandFlowDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Definition = definition
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	if data.PrepareFlow.ValueBool() {
+		flow, err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+			return
+		}
+
+		data.Status = fwtypes.StringEnumValue(flow.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, flowFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	definition, diags := flattenFlowDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, flowFlexOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		definition, diags := expandFlowDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Definition = definition
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+
+		if new.PrepareFlow.ValueBool() {
+			flow, err := prepareFlow(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+			if err != nil {
+				response.Diagnostics.AddError("updating Bedrock Agent Flow", err.Error())
+
+				return
+			}
+
+			new.Status = fwtypes.StringEnumValue(flow.Status)
+		}
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_flow to default value on import.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_flow"), true)...)
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	flow, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return flow, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Validations, func(v awstypes.FlowValidation) error {
+			return errors.New(aws.ToString(v.Message))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flowFlexOptions excludes the union-typed flow definition from AutoFlex.
+func flowFlexOptions(o *fwflex.AutoFlexOptions) {
+	o.AddIgnoredField("Definition")
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	PrepareFlow              types.Bool                                           `tfsdk:"prepare_flow"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     types.Map                                            `tfsdk:"tags"`
+	TagsAll                  types.Map                                            `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[emptyFlowNodeConfigurationModel]          `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	Input          fwtypes.ListNestedObjectValueOf[emptyFlowNodeConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[emptyFlowNodeConfigurationModel]          `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[emptyFlowNodeConfigurationModel]          `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[serviceFlowNodeConfigurationModel]        `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[serviceFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+type emptyFlowNodeConfigurationModel struct{}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	KnowledgeBaseID types.String `tfsdk:"knowledge_base_id"`
+	ModelID         types.String `tfsdk:"model_id"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	SourceConfiguration fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration  fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType           fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type serviceFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type flowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+func expandFlowDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowDefinitionModel]) (*awstypes.FlowDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definitionData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || definitionData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.FlowDefinition{}
+
+	connectionsData, d := definitionData.Connections.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, connectionData := range connectionsData {
+		connection := awstypes.FlowConnection{
+			Name:   fwflex.StringFromFramework(ctx, connectionData.Name),
+			Source: fwflex.StringFromFramework(ctx, connectionData.Source),
+			Target: fwflex.StringFromFramework(ctx, connectionData.Target),
+			Type:   connectionData.Type.ValueEnum(),
+		}
+
+		configuration, d := expandFlowConnectionConfiguration(ctx, connectionData.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		connection.Configuration = configuration
+
+		apiObject.Connections = append(apiObject.Connections, connection)
+	}
+
+	nodesData, d := definitionData.Nodes.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, nodeData := range nodesData {
+		node := awstypes.FlowNode{
+			Name: fwflex.StringFromFramework(ctx, nodeData.Name),
+			Type: nodeData.Type.ValueEnum(),
+		}
+
+		diags.Append(fwflex.Expand(ctx, nodeData.Inputs, &node.Inputs)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, nodeData.Outputs, &node.Outputs)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		configuration, d := expandFlowNodeConfiguration(ctx, nodeData.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		node.Configuration = configuration
+
+		apiObject.Nodes = append(apiObject.Nodes, node)
+	}
+
+	return apiObject, diags
+}
+
+func expandFlowConnectionConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel]) (awstypes.FlowConnectionConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configurationData == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !configurationData.Conditional.IsNull():
+		conditionalData, d := configurationData.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || conditionalData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowConnectionConfigurationMemberConditional{}
+		diags.Append(fwflex.Expand(ctx, conditionalData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.Data.IsNull():
+		dataData, d := configurationData.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || dataData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowConnectionConfigurationMemberData{}
+		diags.Append(fwflex.Expand(ctx, dataData, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandFlowNodeConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel]) (awstypes.FlowNodeConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || configurationData == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !configurationData.Agent.IsNull():
+		agentData, d := configurationData.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || agentData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberAgent{}
+		diags.Append(fwflex.Expand(ctx, agentData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.Collector.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+
+	case !configurationData.Condition.IsNull():
+		conditionData, d := configurationData.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || conditionData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberCondition{}
+		diags.Append(fwflex.Expand(ctx, conditionData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.Input.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+
+	case !configurationData.Iterator.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+
+	case !configurationData.KnowledgeBase.IsNull():
+		knowledgeBaseData, d := configurationData.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || knowledgeBaseData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberKnowledgeBase{}
+		diags.Append(fwflex.Expand(ctx, knowledgeBaseData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.LambdaFunction.IsNull():
+		lambdaFunctionData, d := configurationData.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || lambdaFunctionData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberLambdaFunction{}
+		diags.Append(fwflex.Expand(ctx, lambdaFunctionData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.Lex.IsNull():
+		lexData, d := configurationData.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || lexData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberLex{}
+		diags.Append(fwflex.Expand(ctx, lexData, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !configurationData.Output.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+
+	case !configurationData.Prompt.IsNull():
+		promptData, d := configurationData.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || promptData == nil {
+			return nil, diags
+		}
+
+		sourceConfiguration, d := expandPromptFlowNodeSourceConfiguration(ctx, promptData.SourceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.FlowNodeConfigurationMemberPrompt{
+			Value: awstypes.PromptFlowNodeConfiguration{
+				SourceConfiguration: sourceConfiguration,
+			},
+		}, diags
+
+	case !configurationData.Retrieval.IsNull():
+		retrievalData, d := configurationData.Retrieval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || retrievalData == nil {
+			return nil, diags
+		}
+
+		s3Data, d := expandFlowNodeS3Configuration(ctx, retrievalData.ServiceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberRetrieval{}
+		if s3Data != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.RetrievalFlowNodeS3Configuration{
+					BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+				},
+			}
+		}
+
+		return apiObject, diags
+
+	case !configurationData.Storage.IsNull():
+		storageData, d := configurationData.Storage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || storageData == nil {
+			return nil, diags
+		}
+
+		s3Data, d := expandFlowNodeS3Configuration(ctx, storageData.ServiceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberStorage{}
+		if s3Data != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.StorageFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.StorageFlowNodeS3Configuration{
+					BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+				},
+			}
+		}
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandPromptFlowNodeSourceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel]) (awstypes.PromptFlowNodeSourceConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceConfigurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || sourceConfigurationData == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !sourceConfigurationData.Inline.IsNull():
+		inlineData, d := sourceConfigurationData.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || inlineData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.PromptFlowNodeSourceConfigurationMemberInline{
+			Value: awstypes.PromptFlowNodeInlineConfiguration{
+				ModelId:      fwflex.StringFromFramework(ctx, inlineData.ModelID),
+				TemplateType: inlineData.TemplateType.ValueEnum(),
+			},
+		}
+
+		inferenceConfiguration, d := expandPromptInferenceConfiguration(ctx, inlineData.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Value.InferenceConfiguration = inferenceConfiguration
+
+		templateConfiguration, d := expandPromptTemplateConfiguration(ctx, inlineData.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Value.TemplateConfiguration = templateConfiguration
+
+		return apiObject, diags
+
+	case !sourceConfigurationData.Resource.IsNull():
+		resourceData, d := sourceConfigurationData.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() || resourceData == nil {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.PromptFlowNodeSourceConfigurationMemberResource{}
+		diags.Append(fwflex.Expand(ctx, resourceData, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandFlowNodeS3Configuration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel]) (*flowNodeS3ConfigurationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceConfigurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || serviceConfigurationData == nil {
+		return nil, diags
+	}
+
+	s3Data, d := serviceConfigurationData.S3.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return s3Data, diags
+}
+
+func flattenFlowDefinition(ctx context.Context, apiObject *awstypes.FlowDefinition) (fwtypes.ListNestedObjectValueOf[flowDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+	}
+
+	var connectionsData []*flowConnectionModel
+
+	for _, connection := range apiObject.Connections {
+		connectionsData = append(connectionsData, &flowConnectionModel{
+			Configuration: flattenFlowConnectionConfiguration(ctx, connection.Configuration),
+			Name:          fwflex.StringToFramework(ctx, connection.Name),
+			Source:        fwflex.StringToFramework(ctx, connection.Source),
+			Target:        fwflex.StringToFramework(ctx, connection.Target),
+			Type:          fwtypes.StringEnumValue(connection.Type),
+		})
+	}
+
+	var nodesData []*flowNodeModel
+
+	for _, node := range apiObject.Nodes {
+		nodeData := &flowNodeModel{
+			Name: fwflex.StringToFramework(ctx, node.Name),
+			Type: fwtypes.StringEnumValue(node.Type),
+		}
+
+		diags.Append(fwflex.Flatten(ctx, node.Inputs, &nodeData.Inputs)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		diags.Append(fwflex.Flatten(ctx, node.Outputs, &nodeData.Outputs)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		configuration, d := flattenFlowNodeConfiguration(ctx, node.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		nodeData.Configuration = configuration
+
+		nodesData = append(nodesData, nodeData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowDefinitionModel{
+		Connections: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, connectionsData),
+		Nodes:       fwtypes.NewListNestedObjectValueOfSliceMust(ctx, nodesData),
+	}), diags
+}
+
+func flattenFlowConnectionConfiguration(ctx context.Context, apiObject awstypes.FlowConnectionConfiguration) fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] {
+	configurationData := &flowConnectionConfigurationModel{
+		Conditional: fwtypes.NewListNestedObjectValueOfNull[flowConditionalConnectionConfigurationModel](ctx),
+		Data:        fwtypes.NewListNestedObjectValueOfNull[flowDataConnectionConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowConnectionConfigurationMemberConditional:
+		configurationData.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowConditionalConnectionConfigurationModel{
+			Condition: fwflex.StringToFramework(ctx, v.Value.Condition),
+		})
+	case *awstypes.FlowConnectionConfigurationMemberData:
+		configurationData.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowDataConnectionConfigurationModel{
+			SourceOutput: fwflex.StringToFramework(ctx, v.Value.SourceOutput),
+			TargetInput:  fwflex.StringToFramework(ctx, v.Value.TargetInput),
+		})
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[flowConnectionConfigurationModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configurationData)
+}
+
+func flattenFlowNodeConfiguration(ctx context.Context, apiObject awstypes.FlowNodeConfiguration) (fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	configurationData := &flowNodeConfigurationModel{
+		Agent:          fwtypes.NewListNestedObjectValueOfNull[agentFlowNodeConfigurationModel](ctx),
+		Collector:      fwtypes.NewListNestedObjectValueOfNull[emptyFlowNodeConfigurationModel](ctx),
+		Condition:      fwtypes.NewListNestedObjectValueOfNull[conditionFlowNodeConfigurationModel](ctx),
+		Input:          fwtypes.NewListNestedObjectValueOfNull[emptyFlowNodeConfigurationModel](ctx),
+		Iterator:       fwtypes.NewListNestedObjectValueOfNull[emptyFlowNodeConfigurationModel](ctx),
+		KnowledgeBase:  fwtypes.NewListNestedObjectValueOfNull[knowledgeBaseFlowNodeConfigurationModel](ctx),
+		LambdaFunction: fwtypes.NewListNestedObjectValueOfNull[lambdaFunctionFlowNodeConfigurationModel](ctx),
+		Lex:            fwtypes.NewListNestedObjectValueOfNull[lexFlowNodeConfigurationModel](ctx),
+		Output:         fwtypes.NewListNestedObjectValueOfNull[emptyFlowNodeConfigurationModel](ctx),
+		Prompt:         fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeConfigurationModel](ctx),
+		Retrieval:      fwtypes.NewListNestedObjectValueOfNull[serviceFlowNodeConfigurationModel](ctx),
+		Storage:        fwtypes.NewListNestedObjectValueOfNull[serviceFlowNodeConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowNodeConfigurationMemberAgent:
+		var agentData agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &agentData)...)
+		configurationData.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agentData)
+
+	case *awstypes.FlowNodeConfigurationMemberCollector:
+		configurationData.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &emptyFlowNodeConfigurationModel{})
+
+	case *awstypes.FlowNodeConfigurationMemberCondition:
+		var conditionData conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &conditionData)...)
+		configurationData.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionData)
+
+	case *awstypes.FlowNodeConfigurationMemberInput:
+		configurationData.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &emptyFlowNodeConfigurationModel{})
+
+	case *awstypes.FlowNodeConfigurationMemberIterator:
+		configurationData.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &emptyFlowNodeConfigurationModel{})
+
+	case *awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var knowledgeBaseData knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &knowledgeBaseData)...)
+		configurationData.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &knowledgeBaseData)
+
+	case *awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var lambdaFunctionData lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lambdaFunctionData)...)
+		configurationData.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lambdaFunctionData)
+
+	case *awstypes.FlowNodeConfigurationMemberLex:
+		var lexData lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lexData)...)
+		configurationData.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lexData)
+
+	case *awstypes.FlowNodeConfigurationMemberOutput:
+		configurationData.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &emptyFlowNodeConfigurationModel{})
+
+	case *awstypes.FlowNodeConfigurationMemberPrompt:
+		sourceConfiguration, d := flattenPromptFlowNodeSourceConfiguration(ctx, v.Value.SourceConfiguration)
+		diags.Append(d...)
+		configurationData.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeConfigurationModel{
+			SourceConfiguration: sourceConfiguration,
+		})
+
+	case *awstypes.FlowNodeConfigurationMemberRetrieval:
+		serviceConfiguration := fwtypes.NewListNestedObjectValueOfNull[flowNodeServiceConfigurationModel](ctx)
+		if s3, ok := v.Value.ServiceConfiguration.(*awstypes.RetrievalFlowNodeServiceConfigurationMemberS3); ok {
+			serviceConfiguration = flattenFlowNodeS3Configuration(ctx, s3.Value.BucketName)
+		}
+		configurationData.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &serviceFlowNodeConfigurationModel{
+			ServiceConfiguration: serviceConfiguration,
+		})
+
+	case *awstypes.FlowNodeConfigurationMemberStorage:
+		serviceConfiguration := fwtypes.NewListNestedObjectValueOfNull[flowNodeServiceConfigurationModel](ctx)
+		if s3, ok := v.Value.ServiceConfiguration.(*awstypes.StorageFlowNodeServiceConfigurationMemberS3); ok {
+			serviceConfiguration = flattenFlowNodeS3Configuration(ctx, s3.Value.BucketName)
+		}
+		configurationData.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &serviceFlowNodeConfigurationModel{
+			ServiceConfiguration: serviceConfiguration,
+		})
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[flowNodeConfigurationModel](ctx), diags
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, configurationData), diags
+}
+
+func flattenPromptFlowNodeSourceConfiguration(ctx context.Context, apiObject awstypes.PromptFlowNodeSourceConfiguration) (fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourceConfigurationData := &promptFlowNodeSourceConfigurationModel{
+		Inline:   fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeInlineConfigurationModel](ctx),
+		Resource: fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeResourceConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		inlineData := &promptFlowNodeInlineConfigurationModel{
+			ModelID:      fwflex.StringToFramework(ctx, v.Value.ModelId),
+			TemplateType: fwtypes.StringEnumValue(v.Value.TemplateType),
+		}
+
+		inferenceConfiguration, d := flattenPromptInferenceConfiguration(ctx, v.Value.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		inlineData.InferenceConfiguration = inferenceConfiguration
+
+		templateConfiguration, d := flattenPromptTemplateConfiguration(ctx, v.Value.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		inlineData.TemplateConfiguration = templateConfiguration
+
+		sourceConfigurationData.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, inlineData)
+
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		sourceConfigurationData.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeResourceConfigurationModel{
+			PromptARN: fwflex.StringToFrameworkARN(ctx, v.Value.PromptArn),
+		})
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sourceConfigurationData), diags
+}
+
+func flattenFlowNodeS3Configuration(ctx context.Context, bucketName *string) fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] {
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeServiceConfigurationModel{
+		S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeS3ConfigurationModel{
+			BucketName: fwflex.StringToFramework(ctx, bucketName),
+		}),
+	})
+}
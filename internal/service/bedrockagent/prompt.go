@@ -0,0 +1,625 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Prompt")
+// @Tags(identifierAttribute="arn")
+func newPromptResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &promptResource{}
+
+	return r, nil
+}
+
+type promptResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*promptResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_prompt"
+}
+
+func (r *promptResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"default_variant": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variant": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[promptVariantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"model_id": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"template_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"inference_configuration": promptInferenceConfigurationBlock(ctx),
+						"template_configuration":  promptTemplateConfigurationBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func promptInferenceConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptInferenceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[promptModelInferenceConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"max_tokens": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 4096),
+								},
+							},
+							"stop_sequences": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
+							},
+							"temperature": schema.Float64Attribute{
+								Optional: true,
+							},
+							"top_k": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 500),
+								},
+							},
+							"top_p": schema.Float64Attribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func promptTemplateConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[promptTemplateConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"text": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[textPromptTemplateConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"text": schema.StringAttribute{
+								Required: true,
+								Validators: []validator.String{
+									stringvalidator.LengthBetween(1, 200000),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"input_variable": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptInputVariableModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(5),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *promptResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreatePromptInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, promptFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	variants, diags := expandPromptVariants(ctx, data.Variants)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+	input.Variants = variants
+
+	output, err := conn.CreatePrompt(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Prompt", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findPromptByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Prompt (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, promptFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	variants, diags := flattenPromptVariants(ctx, output.Variants)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Variants = variants
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.DefaultVariant.Equal(old.DefaultVariant) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Variants.Equal(old.Variants) {
+		input := &bedrockagent.UpdatePromptInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, promptFlexOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		variants, diags := expandPromptVariants(ctx, new.Variants)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.PromptIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+		input.Variants = variants
+
+		_, err := conn.UpdatePrompt(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Prompt (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *promptResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data promptResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeletePrompt(ctx, &bedrockagent.DeletePromptInput{
+		PromptIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Prompt (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *promptResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPromptByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetPromptOutput, error) {
+	input := &bedrockagent.GetPromptInput{
+		PromptIdentifier: aws.String(id),
+	}
+
+	return findPrompt(ctx, conn, input)
+}
+
+func findPromptVersionByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, id, version string) (*bedrockagent.GetPromptOutput, error) {
+	input := &bedrockagent.GetPromptInput{
+		PromptIdentifier: aws.String(id),
+		PromptVersion:    aws.String(version),
+	}
+
+	return findPrompt(ctx, conn, input)
+}
+
+func findPrompt(ctx context.Context, conn *bedrockagent.Client, input *bedrockagent.GetPromptInput) (*bedrockagent.GetPromptOutput, error) {
+	output, err := conn.GetPrompt(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// promptFlexOptions excludes the union-typed prompt variants from AutoFlex.
+func promptFlexOptions(o *fwflex.AutoFlexOptions) {
+	o.AddIgnoredField("Variants")
+}
+
+type promptResourceModel struct {
+	ARN                      types.String                                        `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                   `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                         `tfsdk:"customer_encryption_key_arn"`
+	DefaultVariant           types.String                                        `tfsdk:"default_variant"`
+	Description              types.String                                        `tfsdk:"description"`
+	ID                       types.String                                        `tfsdk:"id"`
+	Name                     types.String                                        `tfsdk:"name"`
+	Tags                     types.Map                                           `tfsdk:"tags"`
+	TagsAll                  types.Map                                           `tfsdk:"tags_all"`
+	Variants                 fwtypes.ListNestedObjectValueOf[promptVariantModel] `tfsdk:"variant"`
+	Version                  types.String                                        `tfsdk:"version"`
+}
+
+type promptVariantModel struct {
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	Name                   types.String                                                       `tfsdk:"name"`
+	TemplateConfiguration  fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType           fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptInferenceConfigurationModel struct {
+	Text fwtypes.ListNestedObjectValueOf[promptModelInferenceConfigurationModel] `tfsdk:"text"`
+}
+
+type promptModelInferenceConfigurationModel struct {
+	MaxTokens     types.Int64                       `tfsdk:"max_tokens"`
+	StopSequences fwtypes.ListValueOf[types.String] `tfsdk:"stop_sequences"`
+	Temperature   types.Float64                     `tfsdk:"temperature"`
+	TopK          types.Int64                       `tfsdk:"top_k"`
+	TopP          types.Float64                     `tfsdk:"top_p"`
+}
+
+type promptTemplateConfigurationModel struct {
+	Text fwtypes.ListNestedObjectValueOf[textPromptTemplateConfigurationModel] `tfsdk:"text"`
+}
+
+type textPromptTemplateConfigurationModel struct {
+	InputVariables fwtypes.ListNestedObjectValueOf[promptInputVariableModel] `tfsdk:"input_variable"`
+	Text           types.String                                              `tfsdk:"text"`
+}
+
+type promptInputVariableModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func expandPromptVariants(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptVariantModel]) ([]awstypes.PromptVariant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	variantsData, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.PromptVariant
+
+	for _, variantData := range variantsData {
+		apiObject := awstypes.PromptVariant{
+			ModelId:      fwflex.StringFromFramework(ctx, variantData.ModelID),
+			Name:         fwflex.StringFromFramework(ctx, variantData.Name),
+			TemplateType: variantData.TemplateType.ValueEnum(),
+		}
+
+		inferenceConfiguration, d := expandPromptInferenceConfiguration(ctx, variantData.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.InferenceConfiguration = inferenceConfiguration
+
+		templateConfiguration, d := expandPromptTemplateConfiguration(ctx, variantData.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.TemplateConfiguration = templateConfiguration
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func expandPromptInferenceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel]) (awstypes.PromptInferenceConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inferenceConfigurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || inferenceConfigurationData == nil {
+		return nil, diags
+	}
+
+	textData, d := inferenceConfigurationData.Text.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || textData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.PromptInferenceConfigurationMemberText{}
+	diags.Append(fwflex.Expand(ctx, textData, &apiObject.Value)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func expandPromptTemplateConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]) (awstypes.PromptTemplateConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	templateConfigurationData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || templateConfigurationData == nil {
+		return nil, diags
+	}
+
+	textData, d := templateConfigurationData.Text.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || textData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.PromptTemplateConfigurationMemberText{}
+	diags.Append(fwflex.Expand(ctx, textData, &apiObject.Value)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func flattenPromptVariants(ctx context.Context, apiObjects []awstypes.PromptVariant) (fwtypes.ListNestedObjectValueOf[promptVariantModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[promptVariantModel](ctx), diags
+	}
+
+	var variantsData []*promptVariantModel
+
+	for _, apiObject := range apiObjects {
+		variantData := &promptVariantModel{
+			ModelID:      fwflex.StringToFramework(ctx, apiObject.ModelId),
+			Name:         fwflex.StringToFramework(ctx, apiObject.Name),
+			TemplateType: fwtypes.StringEnumValue(apiObject.TemplateType),
+		}
+
+		inferenceConfiguration, d := flattenPromptInferenceConfiguration(ctx, apiObject.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptVariantModel](ctx), diags
+		}
+
+		variantData.InferenceConfiguration = inferenceConfiguration
+
+		templateConfiguration, d := flattenPromptTemplateConfiguration(ctx, apiObject.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptVariantModel](ctx), diags
+		}
+
+		variantData.TemplateConfiguration = templateConfiguration
+
+		variantsData = append(variantsData, variantData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, variantsData), diags
+}
+
+func flattenPromptInferenceConfiguration(ctx context.Context, apiObject awstypes.PromptInferenceConfiguration) (fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	text, ok := apiObject.(*awstypes.PromptInferenceConfigurationMemberText)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[promptInferenceConfigurationModel](ctx), diags
+	}
+
+	var textData promptModelInferenceConfigurationModel
+	diags.Append(fwflex.Flatten(ctx, text.Value, &textData)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfUnknown[promptInferenceConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptInferenceConfigurationModel{
+		Text: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &textData),
+	}), diags
+}
+
+func flattenPromptTemplateConfiguration(ctx context.Context, apiObject awstypes.PromptTemplateConfiguration) (fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	text, ok := apiObject.(*awstypes.PromptTemplateConfigurationMemberText)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[promptTemplateConfigurationModel](ctx), diags
+	}
+
+	var textData textPromptTemplateConfigurationModel
+	diags.Append(fwflex.Flatten(ctx, text.Value, &textData)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfUnknown[promptTemplateConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptTemplateConfigurationModel{
+		Text: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &textData),
+	}), diags
+}
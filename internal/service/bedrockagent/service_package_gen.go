@@ -47,6 +47,24 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDataSourceResource,
 			Name:    "Data Source",
 		},
+		{
+			Factory: newFlowAliasResource,
+			Name:    "Flow Alias",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newFlowResource,
+			Name:    "Flow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newFlowVersionResource,
+			Name:    "Flow Version",
+		},
 		{
 			Factory: newKnowledgeBaseResource,
 			Name:    "Knowledge Base",
@@ -54,6 +72,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newPromptResource,
+			Name:    "Prompt",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newPromptVersionResource,
+			Name:    "Prompt Version",
+		},
 	}
 }
 
@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The Slack channel must be set up via the AWS Console. It cannot be created via APIs or Terraform.
+// Once it is set up, export the IDs in environment variables to run these tests.
+const (
+	envSlackTeamID    = "CHATBOT_SLACK_TEAM_ID"
+	envSlackChannelID = "CHATBOT_SLACK_CHANNEL_ID"
+)
+
+func TestAccChatbotSlackChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", fmt.Sprintf("chat-configuration/slack-channel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "slack_team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "slack_channel_id", channelID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_channel_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_team_name"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccSlackChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceSlackChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_update(rName, teamID, channelID, "ERROR", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccSlackChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_update(rName, teamID, channelID, "INFO", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, envSlackTeamID)
+	channelID := acctest.SkipIfEnvVarNotSet(t, envSlackChannelID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccSlackChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_slack_channel_configuration" {
+				continue
+			}
+
+			_, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Slack Channel Configuration %s still exists", rs.Primary.Attributes["chat_configuration_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationExists(ctx context.Context, n string, v *awstypes.SlackChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSlackChannelConfigurationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["chat_configuration_arn"], nil
+	}
+}
+
+func testAccSlackChannelConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "chatbot.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_team_id      = %[2]q
+  slack_channel_id   = %[3]q
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_update(rName, teamID, channelID, loggingLevel string, userAuthorizationRequired bool) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_team_id      = %[2]q
+  slack_channel_id   = %[3]q
+
+  guardrail_policy_arns       = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+  logging_level               = %[4]q
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  user_authorization_required = %[5]t
+}
+`, rName, teamID, channelID, loggingLevel, userAuthorizationRequired))
+}
+
+func testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_team_id      = %[2]q
+  slack_channel_id   = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1))
+}
+
+func testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSlackChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_team_id      = %[2]q
+  slack_channel_id   = %[3]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
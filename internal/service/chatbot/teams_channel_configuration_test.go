@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The Microsoft Teams channel must be set up via the AWS Console. It cannot be created via APIs or Terraform.
+// Once it is set up, export the IDs in environment variables to run these tests.
+const (
+	envTeamsChannelID = "CHATBOT_TEAMS_CHANNEL_ID"
+	envTeamsTeamID    = "CHATBOT_TEAMS_TEAM_ID"
+	envTeamsTenantID  = "CHATBOT_TEAMS_TENANT_ID"
+)
+
+func TestAccChatbotTeamsChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	channelID := acctest.SkipIfEnvVarNotSet(t, envTeamsChannelID)
+	teamID := acctest.SkipIfEnvVarNotSet(t, envTeamsTeamID)
+	tenantID := acctest.SkipIfEnvVarNotSet(t, envTeamsTenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, channelID, teamID, tenantID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", fmt.Sprintf("chat-configuration/ms-teams-channel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantID),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "team_name"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTeamsChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	channelID := acctest.SkipIfEnvVarNotSet(t, envTeamsChannelID)
+	teamID := acctest.SkipIfEnvVarNotSet(t, envTeamsTeamID)
+	tenantID := acctest.SkipIfEnvVarNotSet(t, envTeamsTenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, channelID, teamID, tenantID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceTeamsChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	channelID := acctest.SkipIfEnvVarNotSet(t, envTeamsChannelID)
+	teamID := acctest.SkipIfEnvVarNotSet(t, envTeamsTeamID)
+	tenantID := acctest.SkipIfEnvVarNotSet(t, envTeamsTenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_update(rName, channelID, teamID, tenantID, "ERROR", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTeamsChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_update(rName, channelID, teamID, tenantID, "INFO", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	channelID := acctest.SkipIfEnvVarNotSet(t, envTeamsChannelID)
+	teamID := acctest.SkipIfEnvVarNotSet(t, envTeamsTeamID)
+	tenantID := acctest.SkipIfEnvVarNotSet(t, envTeamsTenantID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags1(rName, channelID, teamID, tenantID, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTeamsChannelConfigurationImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "chat_configuration_arn",
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags2(rName, channelID, teamID, tenantID, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_tags1(rName, channelID, teamID, tenantID, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamsChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_teams_channel_configuration" {
+				continue
+			}
+
+			_, err := tfchatbot.FindTeamsChannelConfigurationByARN(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Microsoft Teams Channel Configuration %s still exists", rs.Primary.Attributes["chat_configuration_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTeamsChannelConfigurationExists(ctx context.Context, n string, v *awstypes.TeamsChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindTeamsChannelConfigurationByARN(ctx, conn, rs.Primary.Attributes["chat_configuration_arn"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTeamsChannelConfigurationImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["chat_configuration_arn"], nil
+	}
+}
+
+func testAccTeamsChannelConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "chatbot.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccTeamsChannelConfigurationConfig_basic(rName, channelID, teamID, tenantID string) string {
+	return acctest.ConfigCompose(testAccTeamsChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  channel_id         = %[2]q
+  team_id            = %[3]q
+  tenant_id          = %[4]q
+}
+`, rName, channelID, teamID, tenantID))
+}
+
+func testAccTeamsChannelConfigurationConfig_update(rName, channelID, teamID, tenantID, loggingLevel string, userAuthorizationRequired bool) string {
+	return acctest.ConfigCompose(testAccTeamsChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  channel_id         = %[2]q
+  team_id            = %[3]q
+  tenant_id          = %[4]q
+
+  guardrail_policy_arns       = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+  logging_level               = %[5]q
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  user_authorization_required = %[6]t
+}
+`, rName, channelID, teamID, tenantID, loggingLevel, userAuthorizationRequired))
+}
+
+func testAccTeamsChannelConfigurationConfig_tags1(rName, channelID, teamID, tenantID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTeamsChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  channel_id         = %[2]q
+  team_id            = %[3]q
+  tenant_id          = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+  }
+}
+`, rName, channelID, teamID, tenantID, tagKey1, tagValue1))
+}
+
+func testAccTeamsChannelConfigurationConfig_tags2(rName, channelID, teamID, tenantID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTeamsChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  channel_id         = %[2]q
+  team_id            = %[3]q
+  tenant_id          = %[4]q
+
+  tags = {
+    %[5]q = %[6]q
+    %[7]q = %[8]q
+  }
+}
+`, rName, channelID, teamID, tenantID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
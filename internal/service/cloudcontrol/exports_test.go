@@ -5,7 +5,10 @@ package cloudcontrol
 
 // Exports for use in tests only.
 var (
-	ResourceResource = resourceResource
+	ResourceResource      = resourceResource
+	ResourceTypedResource = newTypedResourceResource
 
-	FindResource = findResource
+	ExpandTypedDesiredStateJSON = expandTypedDesiredStateJSON
+	FindResource                = findResource
+	FlattenTypedProperties      = flattenTypedProperties
 )
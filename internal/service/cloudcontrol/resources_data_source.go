@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudcontrolapi_resources", name="Resources")
+func dataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			names.AttrResources: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrIdentifier: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrProperties: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrol.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrRoleARN); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	resourceDescriptions, err := findResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Cloud Control API (%s) Resources: %s", typeName, err)
+	}
+
+	d.SetId(typeName)
+
+	if err := d.Set(names.AttrResources, flattenResourceDescriptions(resourceDescriptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+func findResources(ctx context.Context, conn *cloudcontrol.Client, input *cloudcontrol.ListResourcesInput) ([]types.ResourceDescription, error) {
+	var output []types.ResourceDescription
+
+	pages := cloudcontrol.NewListResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceDescriptions...)
+	}
+
+	return output, nil
+}
+
+func flattenResourceDescriptions(apiObjects []types.ResourceDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrIdentifier: aws.ToString(apiObject.Identifier),
+			names.AttrProperties: aws.ToString(apiObject.Properties),
+		})
+	}
+
+	return tfList
+}
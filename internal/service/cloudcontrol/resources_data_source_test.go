@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.properties"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type_name", resourceName, "type_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  resource_model = jsonencode({
+    LogGroupName = aws_cloudcontrolapi_resource.test.id
+  })
+}
+`, rName)
+}
@@ -20,7 +20,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newTypedResourceResource,
+			Name:    "Typed Resource",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -30,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  dataSourceResources,
+			TypeName: "aws_cloudcontrolapi_resources",
+			Name:     "Resources",
+		},
 	}
 }
 
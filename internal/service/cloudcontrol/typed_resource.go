@@ -0,0 +1,946 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mattbaird/jsonpatch"
+)
+
+// @FrameworkResource("aws_cloudcontrolapi_typed_resource", name="Typed Resource")
+func newTypedResourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &typedResourceResource{}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultUpdateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type typedResourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+
+	// CloudFormation registry schemas, keyed by type name.
+	schemas sync.Map
+}
+
+func (*typedResourceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudcontrolapi_typed_resource"
+}
+
+func (r *typedResourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"desired_state": schema.DynamicAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrProperties: schema.DynamicAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				Optional: true,
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
+			},
+			"type_version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *typedResourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data typedResourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	desiredState, err := expandTypedDesiredStateJSON(ctx, data.DesiredState)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+		return
+	}
+
+	typeName := data.TypeName.ValueString()
+	input := &cloudcontrol.CreateResourceInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		DesiredState:  aws.String(desiredState),
+		RoleArn:       fwflex.StringFromFramework(ctx, data.RoleARN),
+		TypeName:      aws.String(typeName),
+		TypeVersionId: fwflex.StringFromFramework(ctx, data.TypeVersionID),
+	}
+
+	output, err := conn.CreateResource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", typeName), err.Error())
+
+		return
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) create", typeName, aws.ToString(output.ProgressEvent.Identifier)), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	// Some resources do not set the identifier until after creation.
+	data.ID = fwflex.StringToFramework(ctx, output.ProgressEvent.Identifier)
+	if data.ID.ValueString() == "" {
+		data.ID = fwflex.StringToFramework(ctx, progressEvent.Identifier)
+	}
+
+	resourceDescription, err := findResource(ctx, conn, data.ID.ValueString(), typeName, data.TypeVersionID.ValueString(), data.RoleARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flattenProperties(ctx, resourceDescription, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *typedResourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data typedResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	typeName := data.TypeName.ValueString()
+	resourceDescription, err := findResource(ctx, conn, data.ID.ValueString(), typeName, data.TypeVersionID.ValueString(), data.RoleARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flattenProperties(ctx, resourceDescription, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *typedResourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new typedResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	typeName := new.TypeName.ValueString()
+
+	if !new.DesiredState.Equal(old.DesiredState) {
+		oldDesiredState, err := expandTypedDesiredStateJSON(ctx, old.DesiredState)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+			return
+		}
+
+		newDesiredState, err := expandTypedDesiredStateJSON(ctx, new.DesiredState)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+			return
+		}
+
+		patchDocument, err := patchDocument(oldDesiredState, newDesiredState)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating JSON Patch", err.Error())
+
+			return
+		}
+
+		input := &cloudcontrol.UpdateResourceInput{
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			Identifier:    fwflex.StringFromFramework(ctx, new.ID),
+			PatchDocument: aws.String(patchDocument),
+			RoleArn:       fwflex.StringFromFramework(ctx, new.RoleARN),
+			TypeName:      aws.String(typeName),
+			TypeVersionId: fwflex.StringFromFramework(ctx, new.TypeVersionID),
+		}
+
+		output, err := conn.UpdateResource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", typeName, new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) update", typeName, new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	resourceDescription, err := findResource(ctx, conn, new.ID.ValueString(), typeName, new.TypeVersionID.ValueString(), new.RoleARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flattenProperties(ctx, resourceDescription, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *typedResourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data typedResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	typeName := data.TypeName.ValueString()
+	output, err := conn.DeleteResource(ctx, &cloudcontrol.DeleteResourceInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		Identifier:    fwflex.StringFromFramework(ctx, data.ID),
+		RoleArn:       fwflex.StringFromFramework(ctx, data.RoleARN),
+		TypeName:      aws.String(typeName),
+		TypeVersionId: fwflex.StringFromFramework(ctx, data.TypeVersionID),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.DeleteTimeout(ctx, data.Timeouts))
+
+	if progressEvent != nil && progressEvent.ErrorCode == awstypes.HandlerErrorCodeNotFound {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) delete", typeName, data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *typedResourceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan typedResourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newDesiredState, known, err := expandTypedDesiredState(ctx, plan.DesiredState)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+		return
+	}
+
+	// desired_state can be unknown until apply.
+	if !known || plan.TypeName.IsUnknown() {
+		if !request.State.Raw.IsNull() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrProperties), types.DynamicUnknown())...)
+		}
+
+		return
+	}
+
+	typeName := plan.TypeName.ValueString()
+	registrySchema, err := r.registrySchema(ctx, typeName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFormation Type (%s) schema", typeName), err.Error())
+
+		return
+	}
+
+	newDesiredStateJSON, err := json.Marshal(newDesiredState)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+		return
+	}
+
+	if err := registrySchema.document.ValidateConfigurationDocument(string(newDesiredStateJSON)); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "validating desired_state against CloudFormation Resource Schema", err.Error())
+
+		return
+	}
+
+	for _, v := range registrySchema.resource.ReadOnlyProperties {
+		if hasPropertyPath(newDesiredState, v.Path()) {
+			response.Diagnostics.AddAttributeError(path.Root("desired_state"), "invalid desired_state", fmt.Sprintf("%s is a read-only property of %s", v.String(), typeName))
+		}
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing further to do on create.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var state typedResourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	oldDesiredStateJSON, err := expandTypedDesiredStateJSON(ctx, state.DesiredState)
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "expanding desired_state", err.Error())
+
+		return
+	}
+
+	if oldDesiredStateJSON == string(newDesiredStateJSON) {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrProperties), types.DynamicUnknown())...)
+
+	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateJSON), newDesiredStateJSON)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating desired_state JSON Patch", err.Error())
+
+		return
+	}
+
+	for _, patch := range patches {
+		if isCreateOnlyPropertyPath(registrySchema.resource, patch.Path) {
+			response.RequiresReplace = path.Paths{path.Root("desired_state")}
+
+			break
+		}
+	}
+}
+
+// registrySchema returns the (cached) CloudFormation registry schema for the specified resource type.
+func (r *typedResourceResource) registrySchema(ctx context.Context, typeName string) (*registrySchema, error) {
+	if v, ok := r.schemas.Load(typeName); ok {
+		return v.(*registrySchema), nil
+	}
+
+	output, err := tfcloudformation.FindTypeByName(ctx, r.Meta().CloudFormationClient(ctx), typeName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := newRegistrySchema(aws.ToString(output.Schema))
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.schemas.Store(typeName, v)
+
+	return v, nil
+}
+
+func (r *typedResourceResource) flattenProperties(ctx context.Context, resourceDescription *awstypes.ResourceDescription, data *typedResourceResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeName := data.TypeName.ValueString()
+	registrySchema, err := r.registrySchema(ctx, typeName)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading CloudFormation Type (%s) schema", typeName), err.Error())
+
+		return diags
+	}
+
+	properties, err := flattenTypedProperties(ctx, registrySchema.resource, aws.ToString(resourceDescription.Properties))
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("flattening Cloud Control API (%s) Resource (%s) properties", typeName, data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	data.Properties = properties
+
+	return diags
+}
+
+type typedResourceResourceModel struct {
+	DesiredState  types.Dynamic  `tfsdk:"desired_state"`
+	ID            types.String   `tfsdk:"id"`
+	Properties    types.Dynamic  `tfsdk:"properties"`
+	RoleARN       types.String   `tfsdk:"role_arn"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	TypeName      types.String   `tfsdk:"type_name"`
+	TypeVersionID types.String   `tfsdk:"type_version_id"`
+}
+
+// registrySchema is a parsed CloudFormation registry resource schema.
+type registrySchema struct {
+	document *cfschema.ResourceJsonSchema
+	resource *cfschema.Resource
+}
+
+func newRegistrySchema(document string) (*registrySchema, error) {
+	document, err := cfschema.Sanitize(document)
+
+	if err != nil {
+		return nil, fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	jsonSchema, err := cfschema.NewResourceJsonSchemaDocument(document)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	resource, err := jsonSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return &registrySchema{
+		document: jsonSchema,
+		resource: resource,
+	}, nil
+}
+
+// isCreateOnlyPropertyPath returns whether a change at the specified JSON Pointer path
+// affects any of the resource's create-only properties.
+func isCreateOnlyPropertyPath(resource *cfschema.Resource, path string) bool {
+	changed := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	for _, v := range resource.CreateOnlyProperties {
+		createOnly := v.Path()
+
+		if propertyPathHasPrefix(changed, createOnly) || propertyPathHasPrefix(createOnly, changed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// propertyPathHasPrefix returns whether path begins with prefix.
+// A "*" segment in either path matches any array index.
+func propertyPathHasPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+
+	for i, v := range prefix {
+		if v != path[i] && v != "*" && path[i] != "*" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasPropertyPath returns whether the decoded JSON value contains a value at the specified property path.
+func hasPropertyPath(v any, path []string) bool {
+	if len(path) == 0 {
+		return v != nil
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		return hasPropertyPath(v[path[0]], path[1:])
+	case []any:
+		if path[0] != "*" {
+			return false
+		}
+
+		for _, v := range v {
+			if hasPropertyPath(v, path[1:]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// removePropertyPath removes any value at the specified property path from the decoded JSON value.
+func removePropertyPath(v any, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			delete(v, path[0])
+			return
+		}
+
+		removePropertyPath(v[path[0]], path[1:])
+	case []any:
+		if path[0] != "*" {
+			return
+		}
+
+		for _, v := range v {
+			removePropertyPath(v, path[1:])
+		}
+	}
+}
+
+// expandTypedDesiredState converts a Terraform dynamic value to a decoded JSON object.
+// The returned boolean is false if the value is not yet fully known.
+func expandTypedDesiredState(ctx context.Context, v types.Dynamic) (map[string]any, bool, error) {
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		return nil, false, nil
+	}
+
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, true, errors.New("value must not be null")
+	}
+
+	tfv, err := v.UnderlyingValue().ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !tfv.IsFullyKnown() {
+		return nil, false, nil
+	}
+
+	raw, err := expandTerraformValue(tfv)
+
+	if err != nil {
+		return nil, true, err
+	}
+
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, true, fmt.Errorf("value must be an object, got %s", tfv.Type())
+	}
+
+	return m, true, nil
+}
+
+// expandTypedDesiredStateJSON converts a known Terraform dynamic value to a JSON object string.
+func expandTypedDesiredStateJSON(ctx context.Context, v types.Dynamic) (string, error) {
+	m, known, err := expandTypedDesiredState(ctx, v)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !known {
+		return "", errors.New("value is not known")
+	}
+
+	b, err := json.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// expandTerraformValue converts a known Terraform value to its decoded JSON equivalent.
+// Null object attributes and map elements are omitted.
+func expandTerraformValue(v tftypes.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+
+		return json.Number(n.Text('g', -1)), nil
+
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		s := make([]any, 0, len(elems))
+		for _, elem := range elems {
+			e, err := expandTerraformValue(elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			s = append(s, e)
+		}
+
+		return s, nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]any, len(elems))
+		for k, elem := range elems {
+			if elem.IsNull() {
+				continue
+			}
+
+			e, err := expandTerraformValue(elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			m[k] = e
+		}
+
+		return m, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", typ)
+	}
+}
+
+// flattenTypedProperties converts a JSON properties document to a Terraform dynamic value.
+// Write-only properties are removed and value types are derived from the CloudFormation resource schema.
+func flattenTypedProperties(ctx context.Context, resource *cfschema.Resource, properties string) (types.Dynamic, error) {
+	if properties == "" {
+		return types.DynamicNull(), nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(properties))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return types.DynamicNull(), err
+	}
+
+	for _, v2 := range resource.WriteOnlyProperties {
+		removePropertyPath(v, v2.Path())
+	}
+
+	property := &cfschema.Property{
+		Properties: resource.Properties,
+	}
+
+	value, err := flattenTypedValue(ctx, resource, property, v)
+
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	return types.DynamicValue(value), nil
+}
+
+// flattenTypedValue converts a decoded JSON value to a Terraform value.
+// Arrays whose elements all have the same type become lists, other arrays become tuples.
+func flattenTypedValue(ctx context.Context, resource *cfschema.Resource, property *cfschema.Property, v any) (attr.Value, error) {
+	property, err := resolveProperty(resource, property)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case nil:
+		if typ, ok := primitivePropertyType(property); ok {
+			return nullValue(typ), nil
+		}
+
+		return types.StringNull(), nil
+
+	case bool:
+		return types.BoolValue(v), nil
+
+	case json.Number:
+		n, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NumberValue(n), nil
+
+	case string:
+		return types.StringValue(v), nil
+
+	case []any:
+		var items *cfschema.Property
+		if property != nil {
+			items = property.Items
+		}
+
+		elems := make([]attr.Value, 0, len(v))
+		elemTypes := make([]attr.Type, 0, len(v))
+		for _, e := range v {
+			elem, err := flattenTypedValue(ctx, resource, items, e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elems = append(elems, elem)
+			elemTypes = append(elemTypes, elem.Type(ctx))
+		}
+
+		if elemType, ok := listElementType(resource, items, elemTypes); ok {
+			list, diags := types.ListValue(elemType, elems)
+
+			if diags.HasError() {
+				return nil, fmt.Errorf("creating list value: %v", diags)
+			}
+
+			return list, nil
+		}
+
+		tuple, diags := types.TupleValue(elemTypes, elems)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("creating tuple value: %v", diags)
+		}
+
+		return tuple, nil
+
+	case map[string]any:
+		attrs := make(map[string]attr.Value, len(v))
+		attrTypes := make(map[string]attr.Type, len(v))
+		for k, e := range v {
+			if e == nil {
+				continue
+			}
+
+			elem, err := flattenTypedValue(ctx, resource, childProperty(property, k), e)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrs[k] = elem
+			attrTypes[k] = elem.Type(ctx)
+		}
+
+		object, diags := types.ObjectValue(attrTypes, attrs)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("creating object value: %v", diags)
+		}
+
+		return object, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported JSON value type: %T", v)
+	}
+}
+
+// resolveProperty follows any JSON Pointer references in the property definition.
+func resolveProperty(resource *cfschema.Resource, property *cfschema.Property) (*cfschema.Property, error) {
+	// Guard against reference cycles.
+	for i := 0; property != nil && property.Ref != nil && i < 32; i++ {
+		resolved, err := resource.ResolveReference(*property.Ref)
+
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", property.Ref.String(), err)
+		}
+
+		property = resolved
+	}
+
+	return property, nil
+}
+
+// childProperty returns the schema for the named child of an object property, if any.
+func childProperty(property *cfschema.Property, name string) *cfschema.Property {
+	if property == nil {
+		return nil
+	}
+
+	if v, ok := property.Properties[name]; ok {
+		return v
+	}
+
+	for pattern, v := range property.PatternProperties {
+		if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// listElementType returns the element type to use for an array if all its elements have the same type.
+// The element type of empty arrays is derived from the resource schema.
+func listElementType(resource *cfschema.Resource, items *cfschema.Property, elemTypes []attr.Type) (attr.Type, bool) {
+	if len(elemTypes) == 0 {
+		items, err := resolveProperty(resource, items)
+
+		if err != nil {
+			return nil, false
+		}
+
+		return primitivePropertyType(items)
+	}
+
+	for _, v := range elemTypes[1:] {
+		if !v.Equal(elemTypes[0]) {
+			return nil, false
+		}
+	}
+
+	return elemTypes[0], true
+}
+
+func primitivePropertyType(property *cfschema.Property) (attr.Type, bool) {
+	if property == nil {
+		return nil, false
+	}
+
+	switch property.Type.String() {
+	case cfschema.PropertyTypeBoolean:
+		return types.BoolType, true
+	case cfschema.PropertyTypeInteger, cfschema.PropertyTypeNumber:
+		return types.NumberType, true
+	case cfschema.PropertyTypeString:
+		return types.StringType, true
+	default:
+		return nil, false
+	}
+}
+
+func nullValue(typ attr.Type) attr.Value {
+	switch typ {
+	case types.BoolType:
+		return types.BoolNull()
+	case types.NumberType:
+		return types.NumberNull()
+	default:
+		return types.StringNull()
+	}
+}
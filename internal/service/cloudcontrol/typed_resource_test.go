@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFlattenTypedProperties(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	typeString := cfschema.Type(cfschema.PropertyTypeString)
+	typeArray := cfschema.Type(cfschema.PropertyTypeArray)
+	ref := cfschema.Reference("#/definitions/Tag")
+	resourceSchema := &cfschema.Resource{
+		Definitions: map[string]*cfschema.Property{
+			"Tag": {
+				Properties: map[string]*cfschema.Property{
+					"Key":   {Type: &typeString},
+					"Value": {Type: &typeString},
+				},
+			},
+		},
+		Properties: map[string]*cfschema.Property{
+			"Aliases": {
+				Type:  &typeArray,
+				Items: &cfschema.Property{Type: &typeString},
+			},
+			"Password": {Type: &typeString},
+			"Tags": {
+				Type:  &typeArray,
+				Items: &cfschema.Property{Ref: &ref},
+			},
+		},
+		WriteOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Password"},
+	}
+
+	testCases := []struct {
+		testName   string
+		properties string
+		want       types.Dynamic
+	}{
+		{
+			testName: "empty",
+			want:     types.DynamicNull(),
+		},
+		{
+			testName:   "primitives",
+			properties: `{"Name":"test","Count":3,"Enabled":true,"Password":"secret","Empty":null}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Count":   types.NumberType,
+					"Enabled": types.BoolType,
+					"Name":    types.StringType,
+				},
+				map[string]attr.Value{
+					"Count":   types.NumberValue(big.NewFloat(3)),
+					"Enabled": types.BoolValue(true),
+					"Name":    types.StringValue("test"),
+				},
+			)),
+		},
+		{
+			testName:   "empty list",
+			properties: `{"Aliases":[]}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Aliases": types.ListType{ElemType: types.StringType},
+				},
+				map[string]attr.Value{
+					"Aliases": types.ListValueMust(types.StringType, []attr.Value{}),
+				},
+			)),
+		},
+		{
+			testName:   "list of objects",
+			properties: `{"Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Tags": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}}},
+				},
+				map[string]attr.Value{
+					"Tags": types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}}, []attr.Value{
+						types.ObjectValueMust(map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}, map[string]attr.Value{"Key": types.StringValue("k1"), "Value": types.StringValue("v1")}),
+						types.ObjectValueMust(map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}, map[string]attr.Value{"Key": types.StringValue("k2"), "Value": types.StringValue("v2")}),
+					}),
+				},
+			)),
+		},
+		{
+			testName:   "heterogeneous array",
+			properties: `{"Values":["a",1]}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Values": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}},
+				},
+				map[string]attr.Value{
+					"Values": types.TupleValueMust([]attr.Type{types.StringType, types.NumberType}, []attr.Value{types.StringValue("a"), types.NumberValue(big.NewFloat(1))}),
+				},
+			)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudcontrol.FlattenTypedProperties(ctx, resourceSchema, testCase.properties)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.want) {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestExpandTypedDesiredStateJSON(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"Count":   types.NumberType,
+			"Enabled": types.BoolType,
+			"Name":    types.StringType,
+			"Null":    types.StringType,
+			"Tags":    types.TupleType{ElemTypes: []attr.Type{types.MapType{ElemType: types.StringType}}},
+		},
+		map[string]attr.Value{
+			"Count":   types.NumberValue(big.NewFloat(1.5)),
+			"Enabled": types.BoolValue(false),
+			"Name":    types.StringValue("test"),
+			"Null":    types.StringNull(),
+			"Tags": types.TupleValueMust([]attr.Type{types.MapType{ElemType: types.StringType}}, []attr.Value{
+				types.MapValueMust(types.StringType, map[string]attr.Value{"Key": types.StringValue("k1")}),
+			}),
+		},
+	))
+
+	got, err := tfcloudcontrol.ExpandTypedDesiredStateJSON(ctx, value)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"Count":1.5,"Enabled":false,"Name":"test","Tags":[{"Key":"k1"}]}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if _, err := tfcloudcontrol.ExpandTypedDesiredStateJSON(ctx, types.DynamicValue(types.StringValue("test"))); err == nil {
+		t.Error("expected error for non-object value")
+	}
+}
+
+func TestAccCloudControlTypedResource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_typed_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypedResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypedResourceConfig_basic(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state.LogGroupName", rName),
+					resource.TestCheckResourceAttr(resourceName, "desired_state.RetentionInDays", "7"),
+					resource.TestCheckResourceAttr(resourceName, "properties.LogGroupName", rName),
+					resource.TestCheckResourceAttr(resourceName, "properties.RetentionInDays", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "properties.Arn"),
+				),
+			},
+			{
+				Config: testAccTypedResourceConfig_basic(rName, 14),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "properties.RetentionInDays", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlTypedResource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_typed_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypedResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypedResourceConfig_basic(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcloudcontrol.ResourceTypedResource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudControlTypedResource_createOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_typed_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypedResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTypedResourceConfig_basic(rName1, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "properties.LogGroupName", rName1),
+				),
+			},
+			{
+				Config: testAccTypedResourceConfig_basic(rName2, 7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "properties.LogGroupName", rName2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlTypedResource_invalidPropertyName(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypedResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTypedResourceConfig_invalidPropertyName(),
+				ExpectError: regexp.MustCompile(`validating desired_state against CloudFormation Resource Schema`),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlTypedResource_readOnlyProperty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTypedResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTypedResourceConfig_readOnlyProperty(rName),
+				ExpectError: regexp.MustCompile(`read-only property`),
+			},
+		},
+	})
+}
+
+func testAccCheckTypedResourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudcontrolapi_typed_resource" {
+				continue
+			}
+
+			_, err := tfcloudcontrol.FindResource(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["type_name"], "", "")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Cloud Control API Typed Resource %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTypedResourceConfig_basic(rName string, retentionInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_typed_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = {
+    LogGroupName    = %[1]q
+    RetentionInDays = %[2]d
+  }
+}
+`, rName, retentionInDays)
+}
+
+func testAccTypedResourceConfig_invalidPropertyName() string {
+	return `
+resource "aws_cloudcontrolapi_typed_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = {
+    InvalidName = "testing"
+  }
+}
+`
+}
+
+func testAccTypedResourceConfig_readOnlyProperty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_typed_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = {
+    Arn          = "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:%[1]s:*"
+    LogGroupName = %[1]q
+  }
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
@@ -38,12 +38,18 @@ func dataSourceLogDeliveryCanonicalUserIDRead(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	canonicalId := defaultLogDeliveryCanonicalUserID
 
-	region := meta.(*conns.AWSClient).Region
+	awsClient := meta.(*conns.AWSClient)
+	region := awsClient.Region
 	if v, ok := d.GetOk(names.AttrRegion); ok {
 		region = v.(string)
 	}
 
-	if v := names.PartitionForRegion(region); v == names.ChinaPartitionID {
+	partition := awsClient.Partition
+	if v, ok := awsClient.PartitionForRegion(ctx, region); ok {
+		partition = v.ID
+	}
+
+	if partition == names.ChinaPartitionID {
 		canonicalId = cnLogDeliveryCanonicalUserID
 	}
 
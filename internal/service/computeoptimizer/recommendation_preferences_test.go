@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizer)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_32", "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", "DAYS_32"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, "Ec2Instance"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "scope.0.name", "AccountId"),
+					acctest.CheckResourceAttrAccountID(resourceName, "scope.0.value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_93", "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enhanced_infrastructure_metrics", "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "look_back_period", "DAYS_93"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizer)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_32", "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcomputeoptimizer.ResourceRecommendationPreferences, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_preferredResources(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizer)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_preferredResources,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.0.name", "Ec2InstanceTypes"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.0.include_list.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccRecommendationPreferencesConfig_basic("DAYS_32", "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "preferred_resource.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationPreferences_utilizationPreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_recommendation_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComputeOptimizer)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecommendationPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationPreferencesConfig_utilizationPreferences,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_name", "CpuUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.headroom", "PERCENT_0"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.0.metric_parameters.0.threshold", "P95"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_name", "MemoryUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "utilization_preference.1.metric_parameters.0.headroom", "PERCENT_30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_recommendation_preferences" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Compute Optimizer Recommendation Preferences %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecommendationPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		_, err = tfcomputeoptimizer.FindRecommendationPreferencesByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		return err
+	}
+}
+
+func testAccRecommendationPreferencesConfig_basic(lookBackPeriod, enhancedInfrastructureMetrics string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type                   = "Ec2Instance"
+  look_back_period                = %[1]q
+  enhanced_infrastructure_metrics = %[2]q
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+}
+`, lookBackPeriod, enhancedInfrastructureMetrics)
+}
+
+const testAccRecommendationPreferencesConfig_preferredResources = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type                   = "Ec2Instance"
+  look_back_period                = "DAYS_32"
+  enhanced_infrastructure_metrics = "Active"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  preferred_resource {
+    name         = "Ec2InstanceTypes"
+    include_list = ["m5.xlarge", "r5*"]
+  }
+}
+`
+
+const testAccRecommendationPreferencesConfig_utilizationPreferences = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_recommendation_preferences" "test" {
+  resource_type = "Ec2Instance"
+
+  scope {
+    name  = "AccountId"
+    value = data.aws_caller_identity.current.account_id
+  }
+
+  utilization_preference {
+    metric_name = "CpuUtilization"
+
+    metric_parameters {
+      headroom  = "PERCENT_0"
+      threshold = "P95"
+    }
+  }
+
+  utilization_preference {
+    metric_name = "MemoryUtilization"
+
+    metric_parameters {
+      headroom = "PERCENT_30"
+    }
+  }
+}
+`
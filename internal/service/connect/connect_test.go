@@ -63,6 +63,10 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_KinesisVideoStreamConfig":       testAccInstanceStorageConfigDataSource_KinesisVideoStreamConfig,
 			"dataSource_S3Config":                       testAccInstanceStorageConfigDataSource_S3Config,
 		},
+		"IntegrationAssociation": {
+			acctest.CtBasic:      testAccIntegrationAssociation_basic,
+			acctest.CtDisappears: testAccIntegrationAssociation_disappears,
+		},
 		"LambdaFunctionAssociation": {
 			acctest.CtBasic:      testAccLambdaFunctionAssociation_basic,
 			acctest.CtDisappears: testAccLambdaFunctionAssociation_disappears,
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_integration_association", name="Integration Association")
+// @Tags(identifierAttribute="arn")
+func ResourceIntegrationAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationAssociationCreate,
+		ReadWithoutTimeout:   resourceIntegrationAssociationRead,
+		UpdateWithoutTimeout: resourceIntegrationAssociationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"integration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"integration_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.IntegrationType_Values(), false),
+			},
+			"source_application_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"source_application_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.SourceType_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceIntegrationAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	integrationARN := d.Get("integration_arn").(string)
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:      aws.String(instanceID),
+		IntegrationArn:  aws.String(integrationARN),
+		IntegrationType: aws.String(d.Get("integration_type").(string)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("source_application_name"); ok {
+		input.SourceApplicationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_application_url"); ok {
+		input.SourceApplicationUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_type"); ok {
+		input.SourceType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIntegrationAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Integration Association (%s,%s): %s", instanceID, integrationARN, err)
+	}
+
+	d.SetId(IntegrationAssociationCreateResourceID(instanceID, aws.StringValue(output.IntegrationAssociationId)))
+
+	return append(diags, resourceIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, integrationAssociationID, err := IntegrationAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	integrationAssociation, err := FindIntegrationAssociationByTwoPartKey(ctx, conn, instanceID, integrationAssociationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Integration Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(integrationAssociation.IntegrationAssociationArn)
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrInstanceID, integrationAssociation.InstanceId)
+	d.Set("integration_arn", integrationAssociation.IntegrationArn)
+	d.Set("integration_association_id", integrationAssociation.IntegrationAssociationId)
+	d.Set("integration_type", integrationAssociation.IntegrationType)
+	d.Set("source_application_name", integrationAssociation.SourceApplicationName)
+	d.Set("source_application_url", integrationAssociation.SourceApplicationUrl)
+	d.Set("source_type", integrationAssociation.SourceType)
+
+	// ListIntegrationAssociations does not return tags.
+	tags, err := conn.ListTagsForResourceWithContext(ctx, &connect.ListTagsForResourceInput{
+		ResourceArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	setTagsOut(ctx, tags.Tags)
+
+	return diags
+}
+
+func resourceIntegrationAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceIntegrationAssociationRead(ctx, d, meta)
+}
+
+func resourceIntegrationAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, integrationAssociationID, err := IntegrationAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Integration Association: %s", d.Id())
+	_, err = conn.DeleteIntegrationAssociationWithContext(ctx, &connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(instanceID),
+		IntegrationAssociationId: aws.String(integrationAssociationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const integrationAssociationIDSeparator = ":"
+
+func IntegrationAssociationCreateResourceID(instanceID, integrationAssociationID string) string {
+	parts := []string{instanceID, integrationAssociationID}
+	id := strings.Join(parts, integrationAssociationIDSeparator)
+
+	return id
+}
+
+func IntegrationAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, integrationAssociationIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID"+integrationAssociationIDSeparator+"integrationAssociationID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func FindIntegrationAssociationByTwoPartKey(ctx context.Context, conn *connect.Connect, instanceID, integrationAssociationID string) (*connect.IntegrationAssociationSummary, error) {
+	input := &connect.ListIntegrationAssociationsInput{
+		InstanceId: aws.String(instanceID),
+	}
+	var output *connect.IntegrationAssociationSummary
+
+	err := conn.ListIntegrationAssociationsPagesWithContext(ctx, input, func(page *connect.ListIntegrationAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if v != nil && aws.StringValue(v.IntegrationAssociationId) == integrationAssociationID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
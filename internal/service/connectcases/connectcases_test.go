@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Serialize to limit the number of Cases domains in the account.
+func TestAccConnectCases_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Domain": {
+			acctest.CtBasic:      testAccDomain_basic,
+			acctest.CtDisappears: testAccDomain_disappears,
+			"tags":               testAccDomain_tags,
+		},
+		"Field": {
+			acctest.CtBasic:      testAccField_basic,
+			acctest.CtDisappears: testAccField_disappears,
+			"options":            testAccField_options,
+			"update":             testAccField_update,
+		},
+		"Layout": {
+			acctest.CtBasic:      testAccLayout_basic,
+			acctest.CtDisappears: testAccLayout_disappears,
+			"update":             testAccLayout_update,
+		},
+		"Template": {
+			acctest.CtBasic:      testAccTemplate_basic,
+			acctest.CtDisappears: testAccTemplate_disappears,
+			"update":             testAccTemplate_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Layout")
+// @Tags(identifierAttribute="arn")
+func newLayoutResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &layoutResource{}
+
+	return r, nil
+}
+
+type layoutResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*layoutResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_layout"
+}
+
+func (r *layoutResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	layoutSectionsBlock := func() schema.Block {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[layoutSectionsModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"section": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[sectionModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Blocks: map[string]schema.Block{
+								"field_group": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[fieldGroupModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrName: schema.StringAttribute{
+												Optional: true,
+												Validators: []validator.String{
+													stringvalidator.LengthBetween(0, 100),
+												},
+											},
+										},
+										Blocks: map[string]schema.Block{
+											names.AttrField: schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[fieldItemModel](ctx),
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(220),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrID: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"layout_id":  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrContent: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutContentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[basicLayoutModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"more_info": layoutSectionsBlock(),
+									"top_panel": layoutSectionsBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *layoutResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	content, diags := expandLayoutContent(ctx, data.Content)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateLayoutInput{
+		Content:  content,
+		DomainId: fwflex.StringFromFramework(ctx, data.DomainID),
+		Name:     aws.String(name),
+	}
+
+	output, err := conn.CreateLayout(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Layout (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	data.LayoutID = fwflex.StringToFramework(ctx, output.LayoutId)
+	data.setID()
+
+	// CreateLayout does not accept tags.
+	if err := createTags(ctx, conn, data.ARN.ValueString(), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Layout (%s) tags", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *layoutResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findLayoutByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.LayoutID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Layout (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.LayoutArn)
+	data.Content = flattenLayoutContent(ctx, output.Content)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *layoutResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	if !new.Content.Equal(old.Content) || !new.Name.Equal(old.Name) {
+		content, diags := expandLayoutContent(ctx, new.Content)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &connectcases.UpdateLayoutInput{
+			Content:  content,
+			DomainId: fwflex.StringFromFramework(ctx, new.DomainID),
+			LayoutId: fwflex.StringFromFramework(ctx, new.LayoutID),
+			Name:     fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		_, err := conn.UpdateLayout(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Layout (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *layoutResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data layoutResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	_, err := conn.DeleteLayout(ctx, &connectcases.DeleteLayoutInput{
+		DomainId: aws.String(data.DomainID.ValueString()),
+		LayoutId: aws.String(data.LayoutID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Layout (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *layoutResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findLayoutByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, layoutID string) (*connectcases.GetLayoutOutput, error) {
+	input := &connectcases.GetLayoutInput{
+		DomainId: aws.String(domainID),
+		LayoutId: aws.String(layoutID),
+	}
+
+	output, err := conn.GetLayout(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type layoutResourceModel struct {
+	ARN      types.String                                        `tfsdk:"arn"`
+	Content  fwtypes.ListNestedObjectValueOf[layoutContentModel] `tfsdk:"content"`
+	DomainID types.String                                        `tfsdk:"domain_id"`
+	ID       types.String                                        `tfsdk:"id"`
+	LayoutID types.String                                        `tfsdk:"layout_id"`
+	Name     types.String                                        `tfsdk:"name"`
+	Tags     types.Map                                           `tfsdk:"tags"`
+	TagsAll  types.Map                                           `tfsdk:"tags_all"`
+}
+
+const (
+	layoutResourceIDPartCount = 2
+)
+
+func (data *layoutResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), layoutResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.DomainID = types.StringValue(parts[0])
+	data.LayoutID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *layoutResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DomainID.ValueString(), data.LayoutID.ValueString()}, layoutResourceIDPartCount, false)))
+}
+
+type layoutContentModel struct {
+	Basic fwtypes.ListNestedObjectValueOf[basicLayoutModel] `tfsdk:"basic"`
+}
+
+type basicLayoutModel struct {
+	MoreInfo fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"more_info"`
+	TopPanel fwtypes.ListNestedObjectValueOf[layoutSectionsModel] `tfsdk:"top_panel"`
+}
+
+type layoutSectionsModel struct {
+	Sections fwtypes.ListNestedObjectValueOf[sectionModel] `tfsdk:"section"`
+}
+
+type sectionModel struct {
+	FieldGroup fwtypes.ListNestedObjectValueOf[fieldGroupModel] `tfsdk:"field_group"`
+}
+
+type fieldGroupModel struct {
+	Fields fwtypes.ListNestedObjectValueOf[fieldItemModel] `tfsdk:"field"`
+	Name   types.String                                    `tfsdk:"name"`
+}
+
+type fieldItemModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func expandLayoutContent(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[layoutContentModel]) (awstypes.LayoutContent, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	contentData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || contentData == nil {
+		return nil, diags
+	}
+
+	basicData, d := contentData.Basic.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || basicData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.LayoutContentMemberBasic{}
+
+	apiObject.Value.MoreInfo, d = expandLayoutSections(ctx, basicData.MoreInfo)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject.Value.TopPanel, d = expandLayoutSections(ctx, basicData.TopPanel)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObject, diags
+}
+
+func expandLayoutSections(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[layoutSectionsModel]) (*awstypes.LayoutSections, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	layoutSectionsData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || layoutSectionsData == nil {
+		return nil, diags
+	}
+
+	sectionsData, d := layoutSectionsData.Sections.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.LayoutSections{}
+
+	for _, sectionData := range sectionsData {
+		fieldGroupData, d := sectionData.FieldGroup.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if fieldGroupData == nil {
+			continue
+		}
+
+		section := &awstypes.SectionMemberFieldGroup{}
+		diags.Append(fwflex.Expand(ctx, fieldGroupData, &section.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Sections = append(apiObject.Sections, section)
+	}
+
+	return apiObject, diags
+}
+
+func flattenLayoutContent(ctx context.Context, apiObject awstypes.LayoutContent) fwtypes.ListNestedObjectValueOf[layoutContentModel] {
+	basic, ok := apiObject.(*awstypes.LayoutContentMemberBasic)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[layoutContentModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &layoutContentModel{
+		Basic: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &basicLayoutModel{
+			MoreInfo: flattenLayoutSections(ctx, basic.Value.MoreInfo),
+			TopPanel: flattenLayoutSections(ctx, basic.Value.TopPanel),
+		}),
+	})
+}
+
+func flattenLayoutSections(ctx context.Context, apiObject *awstypes.LayoutSections) fwtypes.ListNestedObjectValueOf[layoutSectionsModel] {
+	if apiObject == nil || len(apiObject.Sections) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[layoutSectionsModel](ctx)
+	}
+
+	var sectionsData []*sectionModel
+
+	for _, v := range apiObject.Sections {
+		fieldGroup, ok := v.(*awstypes.SectionMemberFieldGroup)
+		if !ok {
+			continue
+		}
+
+		fieldGroupData := &fieldGroupModel{}
+		if diags := fwflex.Flatten(ctx, fieldGroup.Value, fieldGroupData); diags.HasError() {
+			continue
+		}
+
+		sectionsData = append(sectionsData, &sectionModel{
+			FieldGroup: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, fieldGroupData),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &layoutSectionsModel{
+		Sections: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, sectionsData),
+	})
+}
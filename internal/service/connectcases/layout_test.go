@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLayout_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "content.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.0.id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "layout_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLayout_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceLayout, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLayout_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connectcases.GetLayoutOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_connectcases_layout.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayoutDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayoutConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccLayoutConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayoutExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.more_info.0.section.0.field_group.0.name", "More info"),
+					resource.TestCheckResourceAttr(resourceName, "content.0.basic.0.top_panel.0.section.0.field_group.0.field.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLayoutDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_layout" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Layout %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLayoutExists(ctx context.Context, n string, v *connectcases.GetLayoutOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		output, err := tfconnectcases.FindLayoutByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["layout_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLayoutConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "Text"
+}
+`, rName)
+}
+
+func testAccLayoutConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+
+  content {
+    basic {
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccLayoutConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccLayoutConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = "%[1]s-updated"
+
+  content {
+    basic {
+      more_info {
+        section {
+          field_group {
+            name = "More info"
+
+            field {
+              id = "status"
+            }
+          }
+        }
+      }
+
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/costoptimizationhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costoptimizationhub/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_costoptimizationhub_enrollment_status", name="Enrollment Status")
+func newEnrollmentStatusResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &enrollmentStatusResource{}
+
+	return r, nil
+}
+
+type enrollmentStatusResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*enrollmentStatusResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_costoptimizationhub_enrollment_status"
+}
+
+func (r *enrollmentStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *enrollmentStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: fwflex.BoolFromFramework(ctx, data.IncludeMemberAccounts),
+		Status:                awstypes.EnrollmentStatusActive,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Cost Optimization Hub Enrollment Status", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+	data.Status = types.StringValue(string(awstypes.EnrollmentStatusActive))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *enrollmentStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Cost Optimization Hub Enrollment Status", err.Error())
+
+		return
+	}
+
+	data.IncludeMemberAccounts = fwflex.BoolToFramework(ctx, output.includeMemberAccounts)
+	data.Status = fwflex.StringValueToFramework(ctx, output.status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: fwflex.BoolFromFramework(ctx, new.IncludeMemberAccounts),
+		Status:                awstypes.EnrollmentStatusActive,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("updating Cost Optimization Hub Enrollment Status", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete opts the account (and, if previously included, its member accounts) out of Cost Optimization Hub.
+func (r *enrollmentStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().CostOptimizationHubClient(ctx)
+
+	input := &costoptimizationhub.UpdateEnrollmentStatusInput{
+		Status: awstypes.EnrollmentStatusInactive,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("deleting Cost Optimization Hub Enrollment Status", err.Error())
+
+		return
+	}
+}
+
+type enrollmentStatus struct {
+	includeMemberAccounts *bool
+	status                awstypes.EnrollmentStatus
+}
+
+func findEnrollmentStatus(ctx context.Context, conn *costoptimizationhub.Client) (*enrollmentStatus, error) {
+	input := &costoptimizationhub.ListEnrollmentStatusesInput{}
+	output := enrollmentStatus{
+		includeMemberAccounts: aws.Bool(false),
+	}
+	var items []awstypes.AccountEnrollmentStatus
+
+	pages := costoptimizationhub.NewListEnrollmentStatusesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page.IncludeMemberAccounts != nil {
+			output.includeMemberAccounts = page.IncludeMemberAccounts
+		}
+		items = append(items, page.Items...)
+	}
+
+	item, err := tfresource.AssertSingleValueResult(items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := item.Status; status == awstypes.EnrollmentStatusInactive {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	output.status = item.Status
+
+	return &output, nil
+}
+
+type enrollmentStatusResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	IncludeMemberAccounts types.Bool   `tfsdk:"include_member_accounts"`
+	Status                types.String `tfsdk:"status"`
+}
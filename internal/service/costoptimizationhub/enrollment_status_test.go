@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcostoptimizationhub "github.com/hashicorp/terraform-provider-aws/internal/service/costoptimizationhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CostOptimizationHub)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CostOptimizationHub)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcostoptimizationhub.ResourceEnrollmentStatus, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_includeMemberAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CostOptimizationHub)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_includeMemberAccounts(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnrollmentStatusDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_costoptimizationhub_enrollment_status" {
+				continue
+			}
+
+			_, err := tfcostoptimizationhub.FindEnrollmentStatus(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Cost Optimization Hub Enrollment Status %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnrollmentStatusExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		_, err := tfcostoptimizationhub.FindEnrollmentStatus(ctx, conn)
+
+		return err
+	}
+}
+
+const testAccEnrollmentStatusConfig_basic = `
+resource "aws_costoptimizationhub_enrollment_status" "test" {}
+`
+
+func testAccEnrollmentStatusConfig_includeMemberAccounts(includeMemberAccounts bool) string {
+	return fmt.Sprintf(`
+resource "aws_costoptimizationhub_enrollment_status" "test" {
+  include_member_accounts = %[1]t
+}
+`, includeMemberAccounts)
+}
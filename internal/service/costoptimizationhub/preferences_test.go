@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package costoptimizationhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/costoptimizationhub/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcostoptimizationhub "github.com/hashicorp/terraform-provider-aws/internal/service/costoptimizationhub"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CostOptimizationHub)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreferencesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", string(awstypes.MemberAccountDiscountVisibilityAll)),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", string(awstypes.SavingsEstimationModeBeforeDiscounts)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreferences_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_costoptimizationhub_preferences.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CostOptimizationHub)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CostOptimizationHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreferencesConfig_full("None", "AfterDiscounts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", string(awstypes.MemberAccountDiscountVisibilityNone)),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", string(awstypes.SavingsEstimationModeAfterDiscounts)),
+				),
+			},
+			{
+				Config: testAccPreferencesConfig_full("All", "BeforeDiscounts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "member_account_discount_visibility", string(awstypes.MemberAccountDiscountVisibilityAll)),
+					resource.TestCheckResourceAttr(resourceName, "savings_estimation_mode", string(awstypes.SavingsEstimationModeBeforeDiscounts)),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckPreferencesDestroy verifies that the preferences have been reset to the service defaults.
+func testAccCheckPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_costoptimizationhub_preferences" {
+				continue
+			}
+
+			output, err := tfcostoptimizationhub.FindPreferences(ctx, conn)
+
+			if err != nil {
+				// Preferences can't be read once the account is no longer enrolled.
+				continue
+			}
+
+			if output.MemberAccountDiscountVisibility != awstypes.MemberAccountDiscountVisibilityAll || output.SavingsEstimationMode != awstypes.SavingsEstimationModeBeforeDiscounts {
+				return fmt.Errorf("Cost Optimization Hub Preferences %s not reset", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostOptimizationHubClient(ctx)
+
+		_, err := tfcostoptimizationhub.FindPreferences(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccPreferencesConfig_basic() string {
+	return acctest.ConfigCompose(testAccEnrollmentStatusConfig_basic, `
+resource "aws_costoptimizationhub_preferences" "test" {
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`)
+}
+
+func testAccPreferencesConfig_full(memberAccountDiscountVisibility, savingsEstimationMode string) string {
+	return acctest.ConfigCompose(testAccEnrollmentStatusConfig_basic, fmt.Sprintf(`
+resource "aws_costoptimizationhub_preferences" "test" {
+  member_account_discount_visibility = %[1]q
+  savings_estimation_mode            = %[2]q
+
+  depends_on = [aws_costoptimizationhub_enrollment_status.test]
+}
+`, memberAccountDiscountVisibility, savingsEstimationMode))
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Asset Type")
+func newResourceAssetType(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAssetType{}, nil
+}
+
+const (
+	ResNameAssetType = "Asset Type"
+
+	assetTypeResourceIDPartCount = 2
+)
+
+type resourceAssetType struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[assetTypeResourceModel]
+}
+
+func (r *resourceAssetType) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_asset_type"
+}
+
+func (r *resourceAssetType) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owning_project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"forms_input": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[formEntryInputModel](ctx),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"map_block_key": schema.StringAttribute{
+							Required: true,
+						},
+						"required": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"type_identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"type_revision": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAssetType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan assetTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateAssetTypeInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateAssetType(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAssetType, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAssetType, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	plan.CreatedBy = flex.StringToFramework(ctx, out.CreatedBy)
+	plan.Revision = flex.StringToFramework(ctx, out.Revision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceAssetType) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state assetTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAssetTypeByName(ctx, conn, state.DomainIdentifier.ValueString(), state.Name.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameAssetType, state.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	state.CreatedBy = flex.StringToFramework(ctx, out.CreatedBy)
+	state.Description = flex.StringToFramework(ctx, out.Description)
+	state.DomainIdentifier = flex.StringToFramework(ctx, out.DomainId)
+	state.FormsInput = flattenFormsOutput(ctx, out.FormsOutput)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.OwningProjectIdentifier = flex.StringToFramework(ctx, out.OwningProjectId)
+	state.Revision = flex.StringToFramework(ctx, out.Revision)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAssetType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state assetTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteAssetTypeInput{
+		DomainIdentifier: aws.String(state.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(state.Name.ValueString()),
+	}
+
+	_, err := conn.DeleteAssetType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameAssetType, state.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceAssetType) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, assetTypeResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-identifier,name'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrName), parts[1])...)
+}
+
+func findAssetTypeByName(ctx context.Context, conn *datazone.Client, domainID, name string) (*datazone.GetAssetTypeOutput, error) {
+	in := &datazone.GetAssetTypeInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(name),
+	}
+
+	out, err := conn.GetAssetType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func flattenFormsOutput(ctx context.Context, apiObjects map[string]awstypes.FormEntryOutput) fwtypes.SetNestedObjectValueOf[formEntryInputModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewSetNestedObjectValueOfNull[formEntryInputModel](ctx)
+	}
+
+	var tfList []*formEntryInputModel
+
+	for k, v := range apiObjects {
+		tfList = append(tfList, &formEntryInputModel{
+			MapBlockKey:    types.StringValue(k),
+			Required:       flex.BoolToFramework(ctx, v.Required),
+			TypeIdentifier: flex.StringToFramework(ctx, v.TypeName),
+			TypeRevision:   flex.StringToFramework(ctx, v.TypeRevision),
+		})
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, tfList)
+}
+
+type assetTypeResourceModel struct {
+	CreatedAt               timetypes.RFC3339                                   `tfsdk:"created_at"`
+	CreatedBy               types.String                                        `tfsdk:"created_by"`
+	Description             types.String                                        `tfsdk:"description"`
+	DomainIdentifier        types.String                                        `tfsdk:"domain_identifier"`
+	FormsInput              fwtypes.SetNestedObjectValueOf[formEntryInputModel] `tfsdk:"forms_input"`
+	Name                    types.String                                        `tfsdk:"name"`
+	OwningProjectIdentifier types.String                                        `tfsdk:"owning_project_identifier"`
+	Revision                types.String                                        `tfsdk:"revision"`
+}
+
+type formEntryInputModel struct {
+	MapBlockKey    types.String `tfsdk:"map_block_key"`
+	Required       types.Bool   `tfsdk:"required"`
+	TypeIdentifier types.String `tfsdk:"type_identifier"`
+	TypeRevision   types.String `tfsdk:"type_revision"`
+}
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment")
+func newResourceEnvironment(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnvironment{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameEnvironment = "Environment"
+
+	environmentResourceIDPartCount = 2
+)
+
+type resourceEnvironment struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceEnvironment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_environment"
+}
+
+func (r *resourceEnvironment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_account_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_blueprint_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_profile_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"glossary_terms": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 20),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_environment": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"user_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[environmentParameterModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceEnvironment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan environmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateEnvironmentInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateEnvironment(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironment, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironment, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.Id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	environment, err := waitEnvironmentCreated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), plan.ID) // Set 'id' so as to taint the resource.
+		resp.State.SetAttribute(ctx, path.Root("domain_identifier"), plan.DomainIdentifier)
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameEnvironment, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, environment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEnvironment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findEnvironmentByID(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+	state.Description = flex.StringToFramework(ctx, out.Description)
+	state.DomainIdentifier = flex.StringToFramework(ctx, out.DomainId)
+	state.EnvironmentProfileIdentifier = flex.StringToFramework(ctx, out.EnvironmentProfileId)
+	state.GlossaryTerms = flex.FlattenFrameworkStringValueList(ctx, out.GlossaryTerms)
+	state.ID = flex.StringToFramework(ctx, out.Id)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+	state.ProjectIdentifier = flex.StringToFramework(ctx, out.ProjectId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEnvironment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state environmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.GlossaryTerms.Equal(state.GlossaryTerms) ||
+		!plan.Name.Equal(state.Name) {
+		in := &datazone.UpdateEnvironmentInput{
+			Description:      flex.StringFromFramework(ctx, plan.Description),
+			DomainIdentifier: aws.String(plan.DomainIdentifier.ValueString()),
+			GlossaryTerms:    flex.ExpandFrameworkStringValueList(ctx, plan.GlossaryTerms),
+			Identifier:       aws.String(plan.ID.ValueString()),
+			Name:             aws.String(plan.Name.ValueString()),
+		}
+
+		out, err := conn.UpdateEnvironment(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironment, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironment, plan.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		_, err = waitEnvironmentUpdated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForUpdate, ResNameEnvironment, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceEnvironment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteEnvironmentInput{
+		DomainIdentifier: aws.String(state.DomainIdentifier.ValueString()),
+		Identifier:       aws.String(state.ID.ValueString()),
+	}
+
+	_, err := conn.DeleteEnvironment(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitEnvironmentDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEnvironment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, environmentResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-identifier,environment-id'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusCreating),
+		Target:  enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastError(err, out)
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusUpdating),
+		Target:  enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastError(err, out)
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusActive, awstypes.EnvironmentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		setEnvironmentLastError(err, out)
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusEnvironment(ctx context.Context, conn *datazone.Client, domainID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findEnvironmentByID(ctx, conn, domainID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func setEnvironmentLastError(err error, out *datazone.GetEnvironmentOutput) {
+	if out.LastDeployment != nil && out.LastDeployment.FailureReason != nil {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.LastDeployment.FailureReason.Message)))
+	}
+}
+
+func findEnvironmentByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetEnvironmentOutput, error) {
+	in := &datazone.GetEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetEnvironment(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if out.Status == awstypes.EnvironmentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(out.Status),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+type environmentResourceModel struct {
+	AwsAccountID                 types.String                                               `tfsdk:"aws_account_id"`
+	AwsAccountRegion             types.String                                               `tfsdk:"aws_account_region"`
+	CreatedAt                    timetypes.RFC3339                                          `tfsdk:"created_at"`
+	CreatedBy                    types.String                                               `tfsdk:"created_by"`
+	Description                  types.String                                               `tfsdk:"description"`
+	DomainIdentifier             types.String                                               `tfsdk:"domain_identifier"`
+	EnvironmentBlueprintID       types.String                                               `tfsdk:"environment_blueprint_id"`
+	EnvironmentProfileIdentifier types.String                                               `tfsdk:"environment_profile_identifier"`
+	GlossaryTerms                types.List                                                 `tfsdk:"glossary_terms"`
+	ID                           types.String                                               `tfsdk:"id"`
+	Name                         types.String                                               `tfsdk:"name"`
+	ProjectIdentifier            types.String                                               `tfsdk:"project_identifier"`
+	ProviderEnvironment          types.String                                               `tfsdk:"provider_environment"`
+	Timeouts                     timeouts.Value                                             `tfsdk:"timeouts"`
+	UserParameters               fwtypes.ListNestedObjectValueOf[environmentParameterModel] `tfsdk:"user_parameters"`
+}
+
+// refreshFromOutput sets the computed attributes from the specified GetEnvironment output.
+func (m *environmentResourceModel) refreshFromOutput(ctx context.Context, out *datazone.GetEnvironmentOutput) {
+	m.AwsAccountID = flex.StringToFramework(ctx, out.AwsAccountId)
+	m.AwsAccountRegion = flex.StringToFramework(ctx, out.AwsAccountRegion)
+	m.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	m.CreatedBy = flex.StringToFramework(ctx, out.CreatedBy)
+	m.EnvironmentBlueprintID = flex.StringToFramework(ctx, out.EnvironmentBlueprintId)
+	m.ProviderEnvironment = flex.StringToFramework(ctx, out.Provider)
+}
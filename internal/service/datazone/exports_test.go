@@ -5,7 +5,26 @@ package datazone
 
 // Exports for use in tests only.
 var (
+	ResourceAssetType                         = newResourceAssetType
 	ResourceDomain                            = newResourceDomain
+	ResourceEnvironment                       = newResourceEnvironment
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
+	ResourceEnvironmentProfile                = newResourceEnvironmentProfile
+	ResourceFormType                          = newResourceFormType
+	ResourceGlossary                          = newResourceGlossary
+	ResourceGlossaryTerm                      = newResourceGlossaryTerm
+	ResourceProject                           = newResourceProject
+	ResourceProjectMembership                 = newResourceProjectMembership
+	ResourceUserProfile                       = newResourceUserProfile
 	IsResourceMissing                         = isResourceMissing
+
+	FindAssetTypeByName                 = findAssetTypeByName
+	FindEnvironmentByID                 = findEnvironmentByID
+	FindEnvironmentProfileByID          = findEnvironmentProfileByID
+	FindFormTypeByName                  = findFormTypeByName
+	FindGlossaryByID                    = findGlossaryByID
+	FindGlossaryTermByID                = findGlossaryTermByID
+	FindProjectByID                     = findProjectByID
+	FindProjectMembershipByThreePartKey = findProjectMembershipByThreePartKey
+	FindUserProfileByUserIdentifier     = findUserProfileByUserIdentifier
 )
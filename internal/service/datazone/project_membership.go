@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Project Membership")
+func newResourceProjectMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceProjectMembership{}, nil
+}
+
+const (
+	ResNameProjectMembership = "Project Membership"
+
+	projectMembershipResourceIDPartCount = 3
+)
+
+type resourceProjectMembership struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[projectMembershipResourceModel]
+}
+
+func (r *resourceProjectMembership) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_project_membership"
+}
+
+func (r *resourceProjectMembership) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"designation": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserDesignation](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"member": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[memberModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"group_identifier": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("group_identifier"),
+									path.MatchRelative().AtParent().AtName("user_identifier"),
+								),
+							},
+						},
+						"user_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan projectMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, d := plan.Member.ToPtr(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateProjectMembershipInput{
+		Designation:       plan.Designation.ValueEnum(),
+		DomainIdentifier:  aws.String(plan.DomainIdentifier.ValueString()),
+		Member:            member.expand(),
+		ProjectIdentifier: aws.String(plan.ProjectIdentifier.ValueString()),
+	}
+
+	_, err := conn.CreateProjectMembership(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProjectMembership, member.identifier(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceProjectMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state projectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, d := state.Member.ToPtr(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findProjectMembershipByThreePartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.ProjectIdentifier.ValueString(), member.identifier())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameProjectMembership, member.identifier(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Designation = fwtypes.StringEnumValue(out.Designation)
+
+	switch v := out.MemberDetails.(type) {
+	case *awstypes.MemberDetailsMemberGroup:
+		state.Member = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &memberModel{
+			GroupIdentifier: flex.StringToFramework(ctx, v.Value.GroupId),
+			UserIdentifier:  types.StringNull(),
+		})
+	case *awstypes.MemberDetailsMemberUser:
+		state.Member = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &memberModel{
+			GroupIdentifier: types.StringNull(),
+			UserIdentifier:  flex.StringToFramework(ctx, v.Value.UserId),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state projectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, d := state.Member.ToPtr(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteProjectMembershipInput{
+		DomainIdentifier:  aws.String(state.DomainIdentifier.ValueString()),
+		Member:            member.expand(),
+		ProjectIdentifier: aws.String(state.ProjectIdentifier.ValueString()),
+	}
+
+	_, err := conn.DeleteProjectMembership(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameProjectMembership, member.identifier(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProjectMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, projectMembershipResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-identifier,project-identifier,member-identifier'", req.ID))
+		return
+	}
+
+	domainID, projectID, memberID := parts[0], parts[1], parts[2]
+
+	out, err := findProjectMembershipByThreePartKey(ctx, r.Meta().DataZoneClient(ctx), domainID, projectID, memberID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing Resource",
+			err.Error(),
+		)
+		return
+	}
+
+	member := &memberModel{
+		GroupIdentifier: types.StringNull(),
+		UserIdentifier:  types.StringNull(),
+	}
+	if _, ok := out.MemberDetails.(*awstypes.MemberDetailsMemberGroup); ok {
+		member.GroupIdentifier = types.StringValue(memberID)
+	} else {
+		member.UserIdentifier = types.StringValue(memberID)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), domainID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), fwtypes.NewListNestedObjectValueOfPtrMust(ctx, member))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_identifier"), projectID)...)
+}
+
+func findProjectMembershipByThreePartKey(ctx context.Context, conn *datazone.Client, domainID, projectID, memberID string) (*awstypes.ProjectMember, error) {
+	in := &datazone.ListProjectMembershipsInput{
+		DomainIdentifier:  aws.String(domainID),
+		ProjectIdentifier: aws.String(projectID),
+	}
+
+	pages := datazone.NewListProjectMembershipsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			if isResourceMissing(err) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.Members {
+			switch details := v.MemberDetails.(type) {
+			case *awstypes.MemberDetailsMemberGroup:
+				if aws.ToString(details.Value.GroupId) == memberID {
+					return &v, nil
+				}
+			case *awstypes.MemberDetailsMemberUser:
+				if aws.ToString(details.Value.UserId) == memberID {
+					return &v, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type projectMembershipResourceModel struct {
+	Designation       fwtypes.StringEnum[awstypes.UserDesignation] `tfsdk:"designation"`
+	DomainIdentifier  types.String                                 `tfsdk:"domain_identifier"`
+	Member            fwtypes.ListNestedObjectValueOf[memberModel] `tfsdk:"member"`
+	ProjectIdentifier types.String                                 `tfsdk:"project_identifier"`
+}
+
+type memberModel struct {
+	GroupIdentifier types.String `tfsdk:"group_identifier"`
+	UserIdentifier  types.String `tfsdk:"user_identifier"`
+}
+
+func (m *memberModel) expand() awstypes.Member {
+	if !m.GroupIdentifier.IsNull() {
+		return &awstypes.MemberMemberGroupIdentifier{
+			Value: m.GroupIdentifier.ValueString(),
+		}
+	}
+
+	return &awstypes.MemberMemberUserIdentifier{
+		Value: m.UserIdentifier.ValueString(),
+	}
+}
+
+func (m *memberModel) identifier() string {
+	if !m.GroupIdentifier.IsNull() {
+		return m.GroupIdentifier.ValueString()
+	}
+
+	return m.UserIdentifier.ValueString()
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneProjectMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v awstypes.ProjectMember
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "designation", "PROJECT_CONTRIBUTOR"),
+					resource.TestCheckResourceAttr(resourceName, "member.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "member.0.user_identifier", "aws_datazone_user_profile.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "project_identifier", "aws_datazone_project.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccProjectMembershipImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_identifier",
+			},
+		},
+	})
+}
+
+func TestAccDataZoneProjectMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v awstypes.ProjectMember
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceProjectMembership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_project_membership" {
+				continue
+			}
+
+			_, err := tfdatazone.FindProjectMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], rs.Primary.Attributes["member.0.user_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameProjectMembership, rs.Primary.Attributes["project_identifier"], err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameProjectMembership, rs.Primary.Attributes["project_identifier"], errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectMembershipExists(ctx context.Context, name string, v *awstypes.ProjectMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameProjectMembership, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindProjectMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], rs.Primary.Attributes["member.0.user_identifier"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameProjectMembership, rs.Primary.Attributes["project_identifier"], err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccProjectMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], rs.Primary.Attributes["member.0.user_identifier"]), nil
+	}
+}
+
+func testAccProjectMembershipConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccUserProfileConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_datazone_project" "test" {
+  domain_identifier   = aws_datazone_domain.test.id
+  name                = %[1]q
+  skip_deletion_check = true
+}
+
+resource "aws_datazone_project_membership" "test" {
+  designation        = "PROJECT_CONTRIBUTOR"
+  domain_identifier  = aws_datazone_domain.test.id
+  project_identifier = aws_datazone_project.test.id
+
+  member {
+    user_identifier = aws_datazone_user_profile.test.id
+  }
+}
+`, rName),
+	)
+}
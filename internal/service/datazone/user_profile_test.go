@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneUserProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v datazone.GetUserProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_user_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "details.0.iam.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "details.0.iam.0.arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ASSIGNED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "IAM"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_identifier", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "IAM_ROLE"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDomainScopedImportStateIdFunc(resourceName, "user_identifier"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_identifier",
+				ImportStateVerifyIgnore:              []string{"user_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckUserProfileExists(ctx context.Context, name string, v *datazone.GetUserProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameUserProfile, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindUserProfileByUserIdentifier(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["user_identifier"], "")
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameUserProfile, rs.Primary.Attributes["user_identifier"], err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccUserProfileConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccDomainConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "%[1]s-user"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "datazone.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName),
+	)
+}
+
+func testAccUserProfileConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccUserProfileConfig_base(rName),
+		`
+resource "aws_datazone_user_profile" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  user_identifier   = aws_iam_role.test.arn
+  user_type         = "IAM_ROLE"
+}
+`)
+}
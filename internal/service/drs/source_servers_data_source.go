@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Source Servers")
+func newSourceServersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServersDataSource{}, nil
+}
+
+type sourceServersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *sourceServersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_drs_source_servers"
+}
+
+func (d *sourceServersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hardware_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"source_server_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_servers": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sourceServerModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[sourceServerModel](ctx),
+				},
+			},
+			"staging_account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *sourceServersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().DRSClient(ctx)
+
+	input := &drs.DescribeSourceServersInput{
+		Filters: &awstypes.DescribeSourceServersRequestFilters{},
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input.Filters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing DRS Source Servers", err.Error())
+
+		return
+	}
+
+	tfList, err := tfslices.ApplyToAllWithError(servers, func(v awstypes.SourceServer) (sourceServerModel, error) {
+		tfObject, diags := flattenSourceServer(ctx, &v)
+		return tfObject, fwdiag.DiagnosticsError(diags)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening DRS Source Servers", err.Error())
+
+		return
+	}
+
+	data.SourceServers = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, tfList)
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServers(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := drs.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+// flattenSourceServer picks the reporting-relevant fields out of the deeply nested API structure.
+func flattenSourceServer(ctx context.Context, apiObject *awstypes.SourceServer) (sourceServerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject := sourceServerModel{
+		AgentVersion:         fwflex.StringToFramework(ctx, apiObject.AgentVersion),
+		ARN:                  fwflex.StringToFramework(ctx, apiObject.Arn),
+		DataReplicationState: types.StringNull(),
+		Hostname:             types.StringNull(),
+		LagDuration:          types.StringNull(),
+		LastLaunchResult:     fwflex.StringValueToFramework(ctx, apiObject.LastLaunchResult),
+		LastSeenDateTime:     types.StringNull(),
+		OriginRegion:         types.StringNull(),
+		RecoveryInstanceID:   fwflex.StringToFramework(ctx, apiObject.RecoveryInstanceId),
+		ReplicationDirection: fwflex.StringValueToFramework(ctx, apiObject.ReplicationDirection),
+		SourceServerID:       fwflex.StringToFramework(ctx, apiObject.SourceServerID),
+	}
+
+	diags.Append(fwflex.Flatten(ctx, apiObject.Tags, &tfObject.Tags)...)
+
+	if v := apiObject.DataReplicationInfo; v != nil {
+		tfObject.DataReplicationState = fwflex.StringValueToFramework(ctx, v.DataReplicationState)
+		tfObject.LagDuration = fwflex.StringToFramework(ctx, v.LagDuration)
+	}
+
+	if v := apiObject.LifeCycle; v != nil {
+		tfObject.LastSeenDateTime = fwflex.StringToFramework(ctx, v.LastSeenByServiceDateTime)
+	}
+
+	if v := apiObject.SourceCloudProperties; v != nil {
+		tfObject.OriginRegion = fwflex.StringToFramework(ctx, v.OriginRegion)
+	}
+
+	if v := apiObject.SourceProperties; v != nil && v.IdentificationHints != nil {
+		tfObject.Hostname = fwflex.StringToFramework(ctx, v.IdentificationHints.Hostname)
+	}
+
+	return tfObject, diags
+}
+
+type sourceServersDataSourceModel struct {
+	HardwareID        types.String                                       `tfsdk:"hardware_id"`
+	ID                types.String                                       `tfsdk:"id"`
+	SourceServerIDs   fwtypes.SetValueOf[types.String]                   `tfsdk:"source_server_ids"`
+	SourceServers     fwtypes.ListNestedObjectValueOf[sourceServerModel] `tfsdk:"source_servers"`
+	StagingAccountIDs fwtypes.SetValueOf[types.String]                   `tfsdk:"staging_account_ids"`
+}
+
+type sourceServerModel struct {
+	AgentVersion         types.String                     `tfsdk:"agent_version"`
+	ARN                  types.String                     `tfsdk:"arn"`
+	DataReplicationState types.String                     `tfsdk:"data_replication_state"`
+	Hostname             types.String                     `tfsdk:"hostname"`
+	LagDuration          types.String                     `tfsdk:"lag_duration"`
+	LastLaunchResult     types.String                     `tfsdk:"last_launch_result"`
+	LastSeenDateTime     types.String                     `tfsdk:"last_seen_date_time"`
+	OriginRegion         types.String                     `tfsdk:"origin_region"`
+	RecoveryInstanceID   types.String                     `tfsdk:"recovery_instance_id"`
+	ReplicationDirection types.String                     `tfsdk:"replication_direction"`
+	SourceServerID       types.String                     `tfsdk:"source_server_id"`
+	Tags                 fwtypes.MapValueOf[types.String] `tfsdk:"tags"`
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_drs_launch_configuration_template", &resource.Sweeper{
+		Name: "aws_drs_launch_configuration_template",
+		F:    sweepLaunchConfigurationTemplates,
+	})
+
+	resource.AddTestSweepers("aws_drs_replication_configuration_template", &resource.Sweeper{
+		Name: "aws_drs_replication_configuration_template",
+		F:    sweepReplicationConfigurationTemplates,
+	})
+}
+
+func sweepLaunchConfigurationTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DRSClient(ctx)
+	input := &drs.DescribeLaunchConfigurationTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := drs.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) || errs.IsA[*awstypes.UninitializedAccountException](err) {
+			log.Printf("[WARN] Skipping DRS Launch Configuration Template sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing DRS Launch Configuration Templates (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newLaunchConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.LaunchConfigurationTemplateID)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DRS Launch Configuration Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepReplicationConfigurationTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DRSClient(ctx)
+	input := &drs.DescribeReplicationConfigurationTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := drs.NewDescribeReplicationConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) || errs.IsA[*awstypes.UninitializedAccountException](err) {
+			log.Printf("[WARN] Skipping DRS Replication Configuration Template sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing DRS Replication Configuration Templates (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newReplicationConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ReplicationConfigurationTemplateID)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping DRS Replication Configuration Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_eip", name="EIP")
 // @Tags(identifierAttribute="id")
+// @Quota(serviceCode="ec2", quotaCode="L-0263D0A3")
 // @Testing(tagsTest=false)
 func resourceEIP() *schema.Resource {
 	return &schema.Resource{
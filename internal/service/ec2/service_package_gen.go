@@ -906,6 +906,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode: "ec2",
+				QuotaCode:   "L-0263D0A3",
+			},
 		},
 		{
 			Factory:  resourceEIPAssociation,
@@ -935,6 +939,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-A4707A72",
+			},
 		},
 		{
 			Factory:  ResourceInternetGatewayAttachment,
@@ -1127,6 +1135,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-F678F1CE",
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_internet_gateway", name="Internet Gateway")
 // @Tags(identifierAttribute="id")
+// @Quota(serviceCode="vpc", quotaCode="L-A4707A72")
 // @Testing(tagsTest=false)
 func ResourceInternetGateway() *schema.Resource {
 	return &schema.Resource{
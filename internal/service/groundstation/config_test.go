@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", "PREFERRED"),
+					resource.TestCheckResourceAttrSet(resourceName, "config_id"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeTracking)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceConfig, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", "PREFERRED"),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tracking(rName, "REQUIRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", "REQUIRED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.units", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.units", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.value", "7812"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.polarization", "RIGHT_HAND"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeAntennaDownlink)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_s3Recording(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_s3Recording(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.s3_recording_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "config_data.0.s3_recording_config.0.bucket_arn", "aws_s3_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.s3_recording_config.0.prefix", "recordings/"),
+					resource.TestCheckResourceAttrPair(resourceName, "config_data.0.s3_recording_config.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeS3Recording)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_config" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["config_id"], awstypes.ConfigCapabilityType(rs.Primary.Attributes["config_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigExists(ctx context.Context, n string, v *groundstation.GetConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["config_id"], awstypes.ConfigCapabilityType(rs.Primary.Attributes["config_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfigConfig_tracking(rName, autotrack string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = %[2]q
+    }
+  }
+}
+`, rName, autotrack)
+}
+
+func testAccConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccConfigConfig_antennaDownlink(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_s3Recording(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-groundstation-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "groundstation.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:PutObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    s3_recording_config {
+      bucket_arn = aws_s3_bucket.test.arn
+      prefix     = "recordings/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
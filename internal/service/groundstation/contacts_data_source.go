@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Contacts")
+func newContactsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &contactsDataSource{}, nil
+}
+
+type contactsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*contactsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_contacts"
+}
+
+func (d *contactsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"contacts": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[contactDataModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[contactDataModel](ctx),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"ground_station": schema.StringAttribute{
+				Optional: true,
+			},
+			"mission_profile_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"satellite_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"status_list": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						enum.FrameworkValidate[awstypes.ContactStatus](),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (d *contactsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data contactsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.ListContactsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findContacts(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Ground Station Contacts", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, struct {
+		Contacts []awstypes.ContactData
+	}{
+		Contacts: output,
+	}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findContacts(ctx context.Context, conn *groundstation.Client, input *groundstation.ListContactsInput) ([]awstypes.ContactData, error) {
+	var output []awstypes.ContactData
+
+	pages := groundstation.NewListContactsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ContactList...)
+	}
+
+	return output, nil
+}
+
+type contactsDataSourceModel struct {
+	Contacts          fwtypes.ListNestedObjectValueOf[contactDataModel] `tfsdk:"contacts"`
+	EndTime           timetypes.RFC3339                                 `tfsdk:"end_time"`
+	GroundStation     types.String                                      `tfsdk:"ground_station"`
+	MissionProfileARN fwtypes.ARN                                       `tfsdk:"mission_profile_arn"`
+	SatelliteARN      fwtypes.ARN                                       `tfsdk:"satellite_arn"`
+	StartTime         timetypes.RFC3339                                 `tfsdk:"start_time"`
+	StatusList        fwtypes.SetValueOf[types.String]                  `tfsdk:"status_list"`
+}
+
+type contactDataModel struct {
+	ContactID           types.String                                    `tfsdk:"contact_id"`
+	ContactStatus       fwtypes.StringEnum[awstypes.ContactStatus]      `tfsdk:"contact_status"`
+	EndTime             timetypes.RFC3339                               `tfsdk:"end_time"`
+	ErrorMessage        types.String                                    `tfsdk:"error_message"`
+	GroundStation       types.String                                    `tfsdk:"ground_station"`
+	MaximumElevation    fwtypes.ListNestedObjectValueOf[elevationModel] `tfsdk:"maximum_elevation"`
+	MissionProfileARN   types.String                                    `tfsdk:"mission_profile_arn"`
+	PostPassEndTime     timetypes.RFC3339                               `tfsdk:"post_pass_end_time"`
+	PrePassStartTime    timetypes.RFC3339                               `tfsdk:"pre_pass_start_time"`
+	Region              types.String                                    `tfsdk:"region"`
+	SatelliteARN        types.String                                    `tfsdk:"satellite_arn"`
+	StartTime           timetypes.RFC3339                               `tfsdk:"start_time"`
+	VisibilityEndTime   timetypes.RFC3339                               `tfsdk:"visibility_end_time"`
+	VisibilityStartTime timetypes.RFC3339                               `tfsdk:"visibility_start_time"`
+}
+
+type elevationModel struct {
+	Unit  fwtypes.StringEnum[awstypes.AngleUnits] `tfsdk:"unit"`
+	Value types.Float64                           `tfsdk:"value"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationContactsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_groundstation_contacts.test"
+	startTime := time.Now().UTC()
+	endTime := startTime.Add(24 * time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactsDataSourceConfig_basic(startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "contacts.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "status_list.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccContactsDataSourceConfig_basic(startTime, endTime string) string {
+	return fmt.Sprintf(`
+data "aws_groundstation_contacts" "test" {
+  start_time  = %[1]q
+  end_time    = %[2]q
+  status_list = ["SCHEDULED", "COMPLETED"]
+}
+`, startTime, endTime)
+}
@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func newMissionProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &missionProfileResource{}
+
+	return r, nil
+}
+
+type missionProfileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*missionProfileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_mission_profile"
+}
+
+func (r *missionProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	contactDurationAttribute := func() schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(1, 21600),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                        framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": contactDurationAttribute(),
+			"contact_pre_pass_duration_seconds":  contactDurationAttribute(),
+			names.AttrID:                         framework.IDAttribute(),
+			"minimum_viable_contact_duration_seconds": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 21600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"streams_kms_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tracking_config_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dataflow_edge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEdgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"streams_kms_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kmsKeyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_alias_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kms_alias_arn"),
+									path.MatchRelative().AtParent().AtName("kms_alias_name"),
+									path.MatchRelative().AtParent().AtName(names.AttrKMSKeyARN),
+								),
+							},
+						},
+						"kms_alias_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *missionProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	dataflowEdges, diags := expandDataflowEdges(ctx, data.DataflowEdges)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	streamsKMSKey, diags := expandKMSKey(ctx, data.StreamsKMSKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &groundstation.CreateMissionProfileInput{
+		ContactPostPassDurationSeconds:      fwflex.Int32FromFramework(ctx, data.ContactPostPassDurationSeconds),
+		ContactPrePassDurationSeconds:       fwflex.Int32FromFramework(ctx, data.ContactPrePassDurationSeconds),
+		DataflowEdges:                       dataflowEdges,
+		MinimumViableContactDurationSeconds: fwflex.Int32FromFramework(ctx, data.MinimumViableContactDurationSeconds),
+		Name:                                aws.String(name),
+		StreamsKmsKey:                       streamsKMSKey,
+		StreamsKmsRole:                      fwflex.StringFromFramework(ctx, data.StreamsKMSRole),
+		Tags:                                getTagsIn(ctx),
+		TrackingConfigArn:                   fwflex.StringFromFramework(ctx, data.TrackingConfigARN),
+	}
+
+	output, err := conn.CreateMissionProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Mission Profile (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.MissionProfileId)
+
+	missionProfile, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, missionProfile.MissionProfileArn)
+	data.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPostPassDurationSeconds)
+	data.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPrePassDurationSeconds)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *missionProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.MissionProfileArn)
+	data.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPostPassDurationSeconds)
+	data.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPrePassDurationSeconds)
+	data.DataflowEdges = flattenDataflowEdges(ctx, output.DataflowEdges)
+	data.MinimumViableContactDurationSeconds = fwflex.Int32ToFramework(ctx, output.MinimumViableContactDurationSeconds)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.StreamsKMSKey = flattenKMSKey(ctx, output.StreamsKmsKey)
+	data.StreamsKMSRole = fwflex.StringToFrameworkARN(ctx, output.StreamsKmsRole)
+	data.TrackingConfigARN = fwflex.StringToFrameworkARN(ctx, output.TrackingConfigArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *missionProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if missionProfileHasChanges(new, old) {
+		dataflowEdges, diags := expandDataflowEdges(ctx, new.DataflowEdges)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		streamsKMSKey, diags := expandKMSKey(ctx, new.StreamsKMSKey)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &groundstation.UpdateMissionProfileInput{
+			ContactPostPassDurationSeconds:      fwflex.Int32FromFramework(ctx, new.ContactPostPassDurationSeconds),
+			ContactPrePassDurationSeconds:       fwflex.Int32FromFramework(ctx, new.ContactPrePassDurationSeconds),
+			DataflowEdges:                       dataflowEdges,
+			MinimumViableContactDurationSeconds: fwflex.Int32FromFramework(ctx, new.MinimumViableContactDurationSeconds),
+			MissionProfileId:                    aws.String(new.ID.ValueString()),
+			Name:                                fwflex.StringFromFramework(ctx, new.Name),
+			StreamsKmsKey:                       streamsKMSKey,
+			StreamsKmsRole:                      fwflex.StringFromFramework(ctx, new.StreamsKMSRole),
+			TrackingConfigArn:                   fwflex.StringFromFramework(ctx, new.TrackingConfigARN),
+		}
+
+		// An empty list removes all dataflow edges.
+		if input.DataflowEdges == nil {
+			input.DataflowEdges = [][]string{}
+		}
+
+		_, err := conn.UpdateMissionProfile(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findMissionProfileByID(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPostPassDurationSeconds)
+		new.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, output.ContactPrePassDurationSeconds)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *missionProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteMissionProfile(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *missionProfileResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func missionProfileHasChanges(plan, state missionProfileResourceModel) bool {
+	return !plan.ContactPostPassDurationSeconds.Equal(state.ContactPostPassDurationSeconds) ||
+		!plan.ContactPrePassDurationSeconds.Equal(state.ContactPrePassDurationSeconds) ||
+		!plan.DataflowEdges.Equal(state.DataflowEdges) ||
+		!plan.MinimumViableContactDurationSeconds.Equal(state.MinimumViableContactDurationSeconds) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.StreamsKMSKey.Equal(state.StreamsKMSKey) ||
+		!plan.StreamsKMSRole.Equal(state.StreamsKMSRole) ||
+		!plan.TrackingConfigARN.Equal(state.TrackingConfigARN)
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type missionProfileResourceModel struct {
+	ARN                                 types.String                                       `tfsdk:"arn"`
+	ContactPostPassDurationSeconds      types.Int64                                        `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds       types.Int64                                        `tfsdk:"contact_pre_pass_duration_seconds"`
+	DataflowEdges                       fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] `tfsdk:"dataflow_edge"`
+	ID                                  types.String                                       `tfsdk:"id"`
+	MinimumViableContactDurationSeconds types.Int64                                        `tfsdk:"minimum_viable_contact_duration_seconds"`
+	Name                                types.String                                       `tfsdk:"name"`
+	StreamsKMSKey                       fwtypes.ListNestedObjectValueOf[kmsKeyModel]       `tfsdk:"streams_kms_key"`
+	StreamsKMSRole                      fwtypes.ARN                                        `tfsdk:"streams_kms_role"`
+	Tags                                types.Map                                          `tfsdk:"tags"`
+	TagsAll                             types.Map                                          `tfsdk:"tags_all"`
+	TrackingConfigARN                   fwtypes.ARN                                        `tfsdk:"tracking_config_arn"`
+}
+
+type dataflowEdgeModel struct {
+	Destination fwtypes.ARN `tfsdk:"destination"`
+	Source      fwtypes.ARN `tfsdk:"source"`
+}
+
+type kmsKeyModel struct {
+	KMSAliasARN  fwtypes.ARN  `tfsdk:"kms_alias_arn"`
+	KMSAliasName types.String `tfsdk:"kms_alias_name"`
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
+
+func expandDataflowEdges(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[dataflowEdgeModel]) ([][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects [][]string
+
+	// Each dataflow edge is a [source, destination] pair of config ARNs.
+	for _, v := range data {
+		apiObjects = append(apiObjects, []string{v.Source.ValueString(), v.Destination.ValueString()})
+	}
+
+	return apiObjects, diags
+}
+
+func flattenDataflowEdges(ctx context.Context, apiObjects [][]string) fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[dataflowEdgeModel](ctx)
+	}
+
+	var data []*dataflowEdgeModel
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		data = append(data, &dataflowEdgeModel{
+			Destination: fwtypes.ARNValue(apiObject[1]),
+			Source:      fwtypes.ARNValue(apiObject[0]),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, data)
+}
+
+func expandKMSKey(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[kmsKeyModel]) (awstypes.KmsKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.KMSAliasARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasArn{
+			Value: data.KMSAliasARN.ValueString(),
+		}, diags
+	case !data.KMSAliasName.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasName{
+			Value: data.KMSAliasName.ValueString(),
+		}, diags
+	case !data.KMSKeyARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsKeyArn{
+			Value: data.KMSKeyARN.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenKMSKey(ctx context.Context, apiObject awstypes.KmsKey) fwtypes.ListNestedObjectValueOf[kmsKeyModel] {
+	data := &kmsKeyModel{
+		KMSAliasARN:  fwtypes.ARNNull(),
+		KMSAliasName: types.StringNull(),
+		KMSKeyARN:    fwtypes.ARNNull(),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.KmsKeyMemberKmsAliasArn:
+		data.KMSAliasARN = fwtypes.ARNValue(v.Value)
+	case *awstypes.KmsKeyMemberKmsAliasName:
+		data.KMSAliasName = types.StringValue(v.Value)
+	case *awstypes.KmsKeyMemberKmsKeyArn:
+		data.KMSKeyARN = fwtypes.ARNValue(v.Value)
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[kmsKeyModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data)
+}
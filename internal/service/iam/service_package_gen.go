@@ -190,6 +190,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Role",
 			},
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode: "iam",
+				QuotaCode:   "L-FE177D64",
+				Global:      true,
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
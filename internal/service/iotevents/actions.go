@@ -0,0 +1,1066 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Action schemas shared by detector model events and alarm model event actions.
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func timerNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+// alarmActionSchemaMap returns the schema of the actions supported by both alarm models and detector models.
+func alarmActionSchemaMap() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dynamodb": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hash_key_field": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"hash_key_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"hash_key_value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"operation": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"payload": payloadSchema(),
+					"payload_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_field": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_type": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"range_key_value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrTableName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"dynamodbv2": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					names.AttrTableName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_site_wise": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"asset_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"entry_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_alias": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"property_value": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"quality": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"timestamp": {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"offset_in_nanos": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"time_in_seconds": {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+								names.AttrValue: {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"boolean_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"double_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"integer_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"string_value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrFunctionARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					names.AttrTargetARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// detectorModelActionSchemaMap returns the schema of the actions supported by detector model events.
+func detectorModelActionSchemaMap() map[string]*schema.Schema {
+	m := alarmActionSchemaMap()
+
+	m["clear_timer"] = timerNameSchema()
+	m["reset_timer"] = timerNameSchema()
+	m["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 31622400),
+				},
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	m["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+					),
+				},
+			},
+		},
+	}
+
+	return m
+}
+
+func expandActionDatas(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{
+			ClearTimer:      expandClearTimerAction(tfMap["clear_timer"].([]interface{})),
+			DynamoDB:        expandDynamoDBAction(tfMap["dynamodb"].([]interface{})),
+			DynamoDBv2:      expandDynamoDBv2Action(tfMap["dynamodbv2"].([]interface{})),
+			Firehose:        expandFirehoseAction(tfMap["firehose"].([]interface{})),
+			IotEvents:       expandIoTEventsAction(tfMap["iot_events"].([]interface{})),
+			IotSiteWise:     expandIoTSiteWiseAction(tfMap["iot_site_wise"].([]interface{})),
+			IotTopicPublish: expandIoTTopicPublishAction(tfMap["iot_topic_publish"].([]interface{})),
+			Lambda:          expandLambdaAction(tfMap["lambda"].([]interface{})),
+			ResetTimer:      expandResetTimerAction(tfMap["reset_timer"].([]interface{})),
+			SetTimer:        expandSetTimerAction(tfMap["set_timer"].([]interface{})),
+			SetVariable:     expandSetVariableAction(tfMap["set_variable"].([]interface{})),
+			Sns:             expandSNSTopicPublishAction(tfMap["sns"].([]interface{})),
+			Sqs:             expandSQSAction(tfMap["sqs"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAlarmActions(tfList []interface{}) []*iotevents.AlarmAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.AlarmAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.AlarmAction{
+			DynamoDB:        expandDynamoDBAction(tfMap["dynamodb"].([]interface{})),
+			DynamoDBv2:      expandDynamoDBv2Action(tfMap["dynamodbv2"].([]interface{})),
+			Firehose:        expandFirehoseAction(tfMap["firehose"].([]interface{})),
+			IotEvents:       expandIoTEventsAction(tfMap["iot_events"].([]interface{})),
+			IotSiteWise:     expandIoTSiteWiseAction(tfMap["iot_site_wise"].([]interface{})),
+			IotTopicPublish: expandIoTTopicPublishAction(tfMap["iot_topic_publish"].([]interface{})),
+			Lambda:          expandLambdaAction(tfMap["lambda"].([]interface{})),
+			Sns:             expandSNSTopicPublishAction(tfMap["sns"].([]interface{})),
+			Sqs:             expandSQSAction(tfMap["sqs"].([]interface{})),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.Payload{}
+
+	if v, ok := tfMap["content_expression"].(string); ok && v != "" {
+		apiObject.ContentExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandClearTimerAction(tfList []interface{}) *iotevents.ClearTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.ClearTimerAction{}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDynamoDBAction(tfList []interface{}) *iotevents.DynamoDBAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DynamoDBAction{}
+
+	if v, ok := tfMap["hash_key_field"].(string); ok && v != "" {
+		apiObject.HashKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_value"].(string); ok && v != "" {
+		apiObject.HashKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrTableName].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDynamoDBv2Action(tfList []interface{}) *iotevents.DynamoDBv2Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DynamoDBv2Action{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap[names.AttrTableName].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFirehoseAction(tfList []interface{}) *iotevents.FirehoseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.FirehoseAction{}
+
+	if v, ok := tfMap["delivery_stream_name"].(string); ok && v != "" {
+		apiObject.DeliveryStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTEventsAction(tfList []interface{}) *iotevents.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.Action{}
+
+	if v, ok := tfMap["input_name"].(string); ok && v != "" {
+		apiObject.InputName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTSiteWiseAction(tfList []interface{}) *iotevents.IotSiteWiseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.IotSiteWiseAction{}
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok {
+		apiObject.PropertyValue = expandAssetPropertyValue(v)
+	}
+
+	return apiObject
+}
+
+func expandAssetPropertyValue(tfList []interface{}) *iotevents.AssetPropertyValue {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AssetPropertyValue{}
+
+	if v, ok := tfMap["quality"].(string); ok && v != "" {
+		apiObject.Quality = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Timestamp = &iotevents.AssetPropertyTimestamp{}
+
+		if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+			apiObject.Timestamp.OffsetInNanos = aws.String(v)
+		}
+
+		if v, ok := tfMap["time_in_seconds"].(string); ok && v != "" {
+			apiObject.Timestamp.TimeInSeconds = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap[names.AttrValue].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Value = &iotevents.AssetPropertyVariant{}
+
+		if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+			apiObject.Value.BooleanValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["double_value"].(string); ok && v != "" {
+			apiObject.Value.DoubleValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+			apiObject.Value.IntegerValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["string_value"].(string); ok && v != "" {
+			apiObject.Value.StringValue = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandIoTTopicPublishAction(tfList []interface{}) *iotevents.IotTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.IotTopicPublishAction{}
+
+	if v, ok := tfMap["mqtt_topic"].(string); ok && v != "" {
+		apiObject.MqttTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandLambdaAction(tfList []interface{}) *iotevents.LambdaAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.LambdaAction{}
+
+	if v, ok := tfMap[names.AttrFunctionARN].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandResetTimerAction(tfList []interface{}) *iotevents.ResetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.ResetTimerAction{}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetTimerAction(tfList []interface{}) *iotevents.SetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SetTimerAction{}
+
+	if v, ok := tfMap["duration_expression"].(string); ok && v != "" {
+		apiObject.DurationExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["seconds"].(int); ok && v != 0 {
+		apiObject.Seconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetVariableAction(tfList []interface{}) *iotevents.SetVariableAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SetVariableAction{}
+
+	if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	if v, ok := tfMap["variable_name"].(string); ok && v != "" {
+		apiObject.VariableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSNSTopicPublishAction(tfList []interface{}) *iotevents.SNSTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SNSTopicPublishAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap[names.AttrTargetARN].(string); ok && v != "" {
+		apiObject.TargetArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSQSAction(tfList []interface{}) *iotevents.SqsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.SqsAction{}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandPayload(v)
+	}
+
+	if v, ok := tfMap["queue_url"].(string); ok && v != "" {
+		apiObject.QueueUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["use_base64"].(bool); ok {
+		apiObject.UseBase64 = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenActionDatas(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"clear_timer":       flattenClearTimerAction(apiObject.ClearTimer),
+			"dynamodb":          flattenDynamoDBAction(apiObject.DynamoDB),
+			"dynamodbv2":        flattenDynamoDBv2Action(apiObject.DynamoDBv2),
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_site_wise":     flattenIoTSiteWiseAction(apiObject.IotSiteWise),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"reset_timer":       flattenResetTimerAction(apiObject.ResetTimer),
+			"set_timer":         flattenSetTimerAction(apiObject.SetTimer),
+			"set_variable":      flattenSetVariableAction(apiObject.SetVariable),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAlarmActions(apiObjects []*iotevents.AlarmAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"dynamodb":          flattenDynamoDBAction(apiObject.DynamoDB),
+			"dynamodbv2":        flattenDynamoDBv2Action(apiObject.DynamoDBv2),
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_site_wise":     flattenIoTSiteWiseAction(apiObject.IotSiteWise),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"content_expression": aws.StringValue(apiObject.ContentExpression),
+		names.AttrType:       aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenClearTimerAction(apiObject *iotevents.ClearTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"timer_name": aws.StringValue(apiObject.TimerName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDynamoDBAction(apiObject *iotevents.DynamoDBAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"hash_key_field":    aws.StringValue(apiObject.HashKeyField),
+		"hash_key_type":     aws.StringValue(apiObject.HashKeyType),
+		"hash_key_value":    aws.StringValue(apiObject.HashKeyValue),
+		"operation":         aws.StringValue(apiObject.Operation),
+		"payload":           flattenPayload(apiObject.Payload),
+		"payload_field":     aws.StringValue(apiObject.PayloadField),
+		"range_key_field":   aws.StringValue(apiObject.RangeKeyField),
+		"range_key_type":    aws.StringValue(apiObject.RangeKeyType),
+		"range_key_value":   aws.StringValue(apiObject.RangeKeyValue),
+		names.AttrTableName: aws.StringValue(apiObject.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDynamoDBv2Action(apiObject *iotevents.DynamoDBv2Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":           flattenPayload(apiObject.Payload),
+		names.AttrTableName: aws.StringValue(apiObject.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFirehoseAction(apiObject *iotevents.FirehoseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"delivery_stream_name": aws.StringValue(apiObject.DeliveryStreamName),
+		"payload":              flattenPayload(apiObject.Payload),
+		"separator":            aws.StringValue(apiObject.Separator),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTEventsAction(apiObject *iotevents.Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_name": aws.StringValue(apiObject.InputName),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"asset_id":       aws.StringValue(apiObject.AssetId),
+		"entry_id":       aws.StringValue(apiObject.EntryId),
+		"property_alias": aws.StringValue(apiObject.PropertyAlias),
+		"property_id":    aws.StringValue(apiObject.PropertyId),
+		"property_value": flattenAssetPropertyValue(apiObject.PropertyValue),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAssetPropertyValue(apiObject *iotevents.AssetPropertyValue) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"quality": aws.StringValue(apiObject.Quality),
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = []interface{}{map[string]interface{}{
+			"offset_in_nanos": aws.StringValue(v.OffsetInNanos),
+			"time_in_seconds": aws.StringValue(v.TimeInSeconds),
+		}}
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap[names.AttrValue] = []interface{}{map[string]interface{}{
+			"boolean_value": aws.StringValue(v.BooleanValue),
+			"double_value":  aws.StringValue(v.DoubleValue),
+			"integer_value": aws.StringValue(v.IntegerValue),
+			"string_value":  aws.StringValue(v.StringValue),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mqtt_topic": aws.StringValue(apiObject.MqttTopic),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLambdaAction(apiObject *iotevents.LambdaAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrFunctionARN: aws.StringValue(apiObject.FunctionArn),
+		"payload":             flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenResetTimerAction(apiObject *iotevents.ResetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"timer_name": aws.StringValue(apiObject.TimerName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSetTimerAction(apiObject *iotevents.SetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"duration_expression": aws.StringValue(apiObject.DurationExpression),
+		"seconds":             aws.Int64Value(apiObject.Seconds),
+		"timer_name":          aws.StringValue(apiObject.TimerName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSetVariableAction(apiObject *iotevents.SetVariableAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrValue: aws.StringValue(apiObject.Value),
+		"variable_name": aws.StringValue(apiObject.VariableName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":           flattenPayload(apiObject.Payload),
+		names.AttrTargetARN: aws.StringValue(apiObject.TargetArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSQSAction(apiObject *iotevents.SqsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"queue_url":  aws.StringValue(apiObject.QueueUrl),
+		"use_base64": aws.BoolValue(apiObject.UseBase64),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,901 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_alarm_model", name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func resourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Each update creates a new alarm model version.
+			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("alarm_capabilities", "alarm_event_actions", "alarm_notification", "alarm_rule", names.AttrDescription, names.AttrRoleARN, "severity")
+			}),
+		),
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			recipientDetailSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"sso_identity": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"identity_store_id": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"user_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"alarm_capabilities": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"acknowledge_flow": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrEnabled: {
+											Type:     schema.TypeBool,
+											Required: true,
+										},
+									},
+								},
+							},
+							"initialization_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"disabled_on_initialization": {
+											Type:     schema.TypeBool,
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"alarm_event_actions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alarm_action": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Resource{
+									Schema: alarmActionSchemaMap(),
+								},
+							},
+						},
+					},
+				},
+				"alarm_notification": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"notification_action": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrAction: {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"lambda_action": {
+														Type:     schema.TypeList,
+														Required: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																names.AttrFunctionARN: {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: verify.ValidARN,
+																},
+																"payload": payloadSchema(),
+															},
+														},
+													},
+												},
+											},
+										},
+										"email_configuration": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrContent: {
+														Type:     schema.TypeList,
+														Optional: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"additional_message": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	ValidateFunc: validation.StringLenBetween(1, 1024),
+																},
+																"subject": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"from": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"recipients": {
+														Type:     schema.TypeList,
+														Required: true,
+														MaxItems: 1,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"to": recipientDetailSchema(),
+															},
+														},
+													},
+												},
+											},
+										},
+										"sms_configuration": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"additional_message": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringLenBetween(1, 1024),
+													},
+													"recipients": recipientDetailSchema(),
+													"sender_id": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"alarm_rule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"simple_rule": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"comparison_operator": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringInSlice(iotevents.ComparisonOperator_Values(), false),
+										},
+										"input_property": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 512),
+										},
+										"threshold": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 512),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrDescription: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 128),
+				},
+				names.AttrKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+					),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"severity": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, math.MaxInt32),
+				},
+				names.AttrStatus: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrTags:    tftags.TagsSchema(),
+				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				names.AttrVersion: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		AlarmRule:      expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+		RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_actions"); ok {
+		input.AlarmEventActions = expandAlarmEventActions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		input.AlarmNotification = expandAlarmNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := conn.CreateAlarmModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := findAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alarm_capabilities", flattenAlarmCapabilities(output.AlarmCapabilities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_capabilities: %s", err)
+	}
+	if err := d.Set("alarm_event_actions", flattenAlarmEventActions(output.AlarmEventActions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_event_actions: %s", err)
+	}
+	if err := d.Set("alarm_notification", flattenAlarmNotification(output.AlarmNotification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_notification: %s", err)
+	}
+	if err := d.Set("alarm_rule", flattenAlarmRule(output.AlarmRule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_rule: %s", err)
+	}
+	d.Set(names.AttrARN, output.AlarmModelArn)
+	d.Set(names.AttrDescription, output.AlarmModelDescription)
+	d.Set(names.AttrKey, output.Key)
+	d.Set(names.AttrName, output.AlarmModelName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrVersion, output.AlarmModelVersion)
+
+	return diags
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmCapabilities:     expandAlarmCapabilities(d.Get("alarm_capabilities").([]interface{})),
+			AlarmEventActions:     expandAlarmEventActions(d.Get("alarm_event_actions").([]interface{})),
+			AlarmModelDescription: aws.String(d.Get(names.AttrDescription).(string)),
+			AlarmModelName:        aws.String(d.Id()),
+			AlarmNotification:     expandAlarmNotification(d.Get("alarm_notification").([]interface{})),
+			AlarmRule:             expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+			RoleArn:               aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int64(int64(v.(int)))
+		}
+
+		// A new alarm model version is created and activated by each update.
+		// Retry while a previous version is still being activated.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAlarmModelWithContext(ctx, input)
+		}, iotevents.ErrCodeResourceInUseException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAlarmModelWithContext(ctx, &iotevents.DeleteAlarmModelInput{
+			AlarmModelName: aws.String(d.Id()),
+		})
+	}, iotevents.ErrCodeResourceInUseException)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAlarmModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findAlarmModelByName returns the latest version of the named alarm model.
+func findAlarmModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AlarmModelArn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.AlarmModelVersionStatusActivating},
+		Target:  []string{iotevents.AlarmModelVersionStatusActive},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		if status := aws.StringValue(output.Status); status == iotevents.AlarmModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAlarmModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.AlarmModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarmCapabilities(tfList []interface{}) *iotevents.AlarmCapabilities {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmCapabilities{}
+
+	if v, ok := tfMap["acknowledge_flow"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AcknowledgeFlow = &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(v[0].(map[string]interface{})[names.AttrEnabled].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["initialization_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InitializationConfiguration = &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(v[0].(map[string]interface{})["disabled_on_initialization"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAlarmEventActions(tfList []interface{}) *iotevents.AlarmEventActions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.AlarmEventActions{
+		AlarmActions: expandAlarmActions(tfMap["alarm_action"].([]interface{})),
+	}
+}
+
+func expandAlarmNotification(tfList []interface{}) *iotevents.AlarmNotification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmNotification{}
+
+	for _, tfMapRaw := range tfMap["notification_action"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		notificationAction := &iotevents.NotificationAction{}
+
+		if v, ok := tfMap[names.AttrAction].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			notificationAction.Action = &iotevents.NotificationTargetActions{
+				LambdaAction: expandLambdaAction(v[0].(map[string]interface{})["lambda_action"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["email_configuration"].([]interface{}); ok {
+			notificationAction.EmailConfigurations = expandEmailConfigurations(v)
+		}
+
+		if v, ok := tfMap["sms_configuration"].([]interface{}); ok {
+			notificationAction.SmsConfigurations = expandSMSConfigurations(v)
+		}
+
+		apiObject.NotificationActions = append(apiObject.NotificationActions, notificationAction)
+	}
+
+	return apiObject
+}
+
+func expandEmailConfigurations(tfList []interface{}) []*iotevents.EmailConfiguration {
+	var apiObjects []*iotevents.EmailConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.EmailConfiguration{
+			From: aws.String(tfMap["from"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrContent].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.Content = &iotevents.EmailContent{}
+
+			if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+				apiObject.Content.AdditionalMessage = aws.String(v)
+			}
+
+			if v, ok := tfMap["subject"].(string); ok && v != "" {
+				apiObject.Content.Subject = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["recipients"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Recipients = &iotevents.EmailRecipients{
+				To: expandRecipientDetails(v[0].(map[string]interface{})["to"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSMSConfigurations(tfList []interface{}) []*iotevents.SMSConfiguration {
+	var apiObjects []*iotevents.SMSConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.SMSConfiguration{
+			Recipients: expandRecipientDetails(tfMap["recipients"].([]interface{})),
+		}
+
+		if v, ok := tfMap["additional_message"].(string); ok && v != "" {
+			apiObject.AdditionalMessage = aws.String(v)
+		}
+
+		if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+			apiObject.SenderId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRecipientDetails(tfList []interface{}) []*iotevents.RecipientDetail {
+	var apiObjects []*iotevents.RecipientDetail
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.RecipientDetail{}
+
+		if v, ok := tfMap["sso_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SsoIdentity = &iotevents.SSOIdentity{
+				IdentityStoreId: aws.String(tfMap["identity_store_id"].(string)),
+			}
+
+			if v, ok := tfMap["user_id"].(string); ok && v != "" {
+				apiObject.SsoIdentity.UserId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAlarmRule(tfList []interface{}) *iotevents.AlarmRule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SimpleRule = &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAlarmCapabilities(apiObject *iotevents.AlarmCapabilities) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow"] = []interface{}{map[string]interface{}{
+			names.AttrEnabled: aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["initialization_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_on_initialization": aws.BoolValue(v.DisabledOnInitialization),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmEventActions(apiObject *iotevents.AlarmEventActions) []interface{} {
+	if apiObject == nil || len(apiObject.AlarmActions) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"alarm_action": flattenAlarmActions(apiObject.AlarmActions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmNotification(apiObject *iotevents.AlarmNotification) []interface{} {
+	if apiObject == nil || len(apiObject.NotificationActions) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.NotificationActions {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"email_configuration": flattenEmailConfigurations(apiObject.EmailConfigurations),
+			"sms_configuration":   flattenSMSConfigurations(apiObject.SmsConfigurations),
+		}
+
+		if v := apiObject.Action; v != nil {
+			tfMap[names.AttrAction] = []interface{}{map[string]interface{}{
+				"lambda_action": flattenLambdaAction(v.LambdaAction),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	tfMap := map[string]interface{}{
+		"notification_action": tfList,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEmailConfigurations(apiObjects []*iotevents.EmailConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"from": aws.StringValue(apiObject.From),
+		}
+
+		if v := apiObject.Content; v != nil {
+			tfMap[names.AttrContent] = []interface{}{map[string]interface{}{
+				"additional_message": aws.StringValue(v.AdditionalMessage),
+				"subject":            aws.StringValue(v.Subject),
+			}}
+		}
+
+		if v := apiObject.Recipients; v != nil {
+			tfMap["recipients"] = []interface{}{map[string]interface{}{
+				"to": flattenRecipientDetails(v.To),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenSMSConfigurations(apiObjects []*iotevents.SMSConfiguration) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"additional_message": aws.StringValue(apiObject.AdditionalMessage),
+			"recipients":         flattenRecipientDetails(apiObject.Recipients),
+			"sender_id":          aws.StringValue(apiObject.SenderId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRecipientDetails(apiObjects []*iotevents.RecipientDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.SsoIdentity; v != nil {
+			tfMap["sso_identity"] = []interface{}{map[string]interface{}{
+				"identity_store_id": aws.StringValue(v.IdentityStoreId),
+				"user_id":           aws.StringValue(v.UserId),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAlarmRule(apiObject *iotevents.AlarmRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": aws.StringValue(v.ComparisonOperator),
+			"input_property":      aws.StringValue(v.InputProperty),
+			"threshold":           aws.StringValue(v.Threshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
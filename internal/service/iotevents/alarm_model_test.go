@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, iotevents.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alarm_notification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "60"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", regexache.MustCompile(`alarmModel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, iotevents.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceAlarmModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, iotevents.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAlarmModelConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.initialization_configuration.0.disabled_on_initialization", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_actions.0.alarm_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_event_actions.0.alarm_action.0.sns.0.target_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER_OR_EQUAL"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Temperature alarm"),
+					resource.TestCheckResourceAttr(resourceName, "severity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, iotevents.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlarmModelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAlarmModelConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_alarm_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlarmModelExists(ctx context.Context, n string, v *iotevents.DescribeAlarmModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		output, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlarmModelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iotevents_input" "test" {
+  name = replace(%[1]q, "-", "_")
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAlarmModelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "60"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAlarmModelConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_alarm_model" "test" {
+  name        = %[1]q
+  description = "Temperature alarm"
+  role_arn    = aws_iam_role.test.arn
+  severity    = 2
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER_OR_EQUAL"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "80"
+    }
+  }
+
+  alarm_capabilities {
+    acknowledge_flow {
+      enabled = true
+    }
+
+    initialization_configuration {
+      disabled_on_initialization = false
+    }
+  }
+
+  alarm_event_actions {
+    alarm_action {
+      sns {
+        target_arn = aws_sns_topic.test.arn
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAlarmModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "60"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAlarmModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAlarmModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.${aws_iotevents_input.test.name}.temperature"
+      threshold           = "60"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_detector_model", name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func resourceDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorModelCreate,
+		ReadWithoutTimeout:   resourceDetectorModelRead,
+		UpdateWithoutTimeout: resourceDetectorModelUpdate,
+		DeleteWithoutTimeout: resourceDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Each update creates a new detector model version.
+			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("definition", names.AttrDescription, "evaluation_method", names.AttrRoleARN)
+			}),
+		),
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			eventSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrAction: {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: detectorModelActionSchemaMap(),
+								},
+							},
+							names.AttrCondition: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 512),
+							},
+							"event_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(0, 128),
+							},
+						},
+					},
+				}
+			}
+
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"definition": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"initial_state_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							names.AttrState: {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"on_enter": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"event": eventSchema(),
+												},
+											},
+										},
+										"on_exit": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"event": eventSchema(),
+												},
+											},
+										},
+										"on_input": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"event": eventSchema(),
+													"transition_event": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																names.AttrAction: {
+																	Type:     schema.TypeList,
+																	Optional: true,
+																	Elem: &schema.Resource{
+																		Schema: detectorModelActionSchemaMap(),
+																	},
+																},
+																names.AttrCondition: {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringLenBetween(0, 512),
+																},
+																"event_name": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringLenBetween(0, 128),
+																},
+																"next_state": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: validation.StringLenBetween(1, 128),
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"state_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				names.AttrDescription: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 128),
+				},
+				"evaluation_method": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+				},
+				names.AttrKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+					),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrStatus: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrTags:    tftags.TagsSchema(),
+				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				names.AttrVersion: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func resourceDetectorModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: expandDetectorModelDefinition(d.Get("definition").([]interface{})),
+		DetectorModelName:       aws.String(name),
+		RoleArn:                 aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_method"); ok {
+		input.EvaluationMethod = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateDetectorModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Detector Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := findDetectorModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.DetectorModelConfiguration
+	d.Set(names.AttrARN, configuration.DetectorModelArn)
+	if err := d.Set("definition", flattenDetectorModelDefinition(output.DetectorModelDefinition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting definition: %s", err)
+	}
+	d.Set(names.AttrDescription, configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set(names.AttrKey, configuration.Key)
+	d.Set(names.AttrName, configuration.DetectorModelName)
+	d.Set(names.AttrRoleARN, configuration.RoleArn)
+	d.Set(names.AttrStatus, configuration.Status)
+	d.Set(names.AttrVersion, configuration.DetectorModelVersion)
+
+	return diags
+}
+
+func resourceDetectorModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  expandDetectorModelDefinition(d.Get("definition").([]interface{})),
+			DetectorModelDescription: aws.String(d.Get(names.AttrDescription).(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			RoleArn:                  aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk("evaluation_method"); ok {
+			input.EvaluationMethod = aws.String(v.(string))
+		}
+
+		// A new detector model version is created and activated by each update.
+		// Retry while a previous version is still being activated.
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateDetectorModelWithContext(ctx, input)
+		}, iotevents.ErrCodeResourceInUseException)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Detector Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDetectorModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDetectorModelRead(ctx, d, meta)...)
+}
+
+func resourceDetectorModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteDetectorModelWithContext(ctx, &iotevents.DeleteDetectorModelInput{
+			DetectorModelName: aws.String(d.Id()),
+		})
+	}, iotevents.ErrCodeResourceInUseException)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Detector Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Detector Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// findDetectorModelByName returns the latest version of the named detector model.
+func findDetectorModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		if status := aws.StringValue(output.DetectorModelConfiguration.Status); status == iotevents.DetectorModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDetectorModelDefinition(tfList []interface{}) *iotevents.DetectorModelDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.DetectorModelDefinition{}
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrState].([]interface{}); ok {
+		apiObject.States = expandStates(v)
+	}
+
+	return apiObject
+}
+
+func expandStates(tfList []interface{}) []*iotevents.State {
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.State{
+			StateName: aws.String(tfMap["state_name"].(string)),
+		}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+				Events: expandEvents(tfMap["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OnExit = &iotevents.OnExitLifecycle{
+				Events: expandEvents(tfMap["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.OnInput = &iotevents.OnInputLifecycle{
+				Events:           expandEvents(tfMap["event"].([]interface{})),
+				TransitionEvents: expandTransitionEvents(tfMap["transition_event"].([]interface{})),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEvents(tfList []interface{}) []*iotevents.Event {
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{
+			Actions:   expandActionDatas(tfMap[names.AttrAction].([]interface{})),
+			EventName: aws.String(tfMap["event_name"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCondition].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.TransitionEvent{
+			Actions:   expandActionDatas(tfMap[names.AttrAction].([]interface{})),
+			Condition: aws.String(tfMap[names.AttrCondition].(string)),
+			EventName: aws.String(tfMap["event_name"].(string)),
+			NextState: aws.String(tfMap["next_state"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"initial_state_name": aws.StringValue(apiObject.InitialStateName),
+		names.AttrState:      flattenStates(apiObject.States),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStates(apiObjects []*iotevents.State) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"state_name": aws.StringValue(apiObject.StateName),
+		}
+
+		if v := apiObject.OnEnter; v != nil && len(v.Events) > 0 {
+			tfMap["on_enter"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnExit; v != nil && len(v.Events) > 0 {
+			tfMap["on_exit"] = []interface{}{map[string]interface{}{
+				"event": flattenEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnInput; v != nil && (len(v.Events) > 0 || len(v.TransitionEvents) > 0) {
+			tfMap["on_input"] = []interface{}{map[string]interface{}{
+				"event":            flattenEvents(v.Events),
+				"transition_event": flattenTransitionEvents(v.TransitionEvents),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvents(apiObjects []*iotevents.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:    flattenActionDatas(apiObject.Actions),
+			names.AttrCondition: aws.StringValue(apiObject.Condition),
+			"event_name":        aws.StringValue(apiObject.EventName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:    flattenActionDatas(apiObject.Actions),
+			names.AttrCondition: aws.StringValue(apiObject.Condition),
+			"event_name":        aws.StringValue(apiObject.EventName),
+			"next_state":        aws.StringValue(apiObject.NextState),
+		})
+	}
+
+	return tfList
+}
@@ -6,8 +6,12 @@ package lakeformation
 // exports used for testing only.
 var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
+	ResourceLFTagExpression = newResourceLFTagExpression
+	ResourceOptIn           = newResourceOptIn
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
+	FindDataCellsFilterByID         = findDataCellsFilterByID
+	FindLFTagExpressionByID         = findLFTagExpressionByID
+	FindOptInByPrincipalAndResource = findOptInByPrincipalAndResource
+	FindResourceLFTagByID           = findResourceLFTagByID
 )
@@ -29,6 +29,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			acctest.CtBasic:  testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			acctest.CtBasic:      testAccOptIn_basic,
+			acctest.CtDisappears: testAccOptIn_disappears,
+			"condition":          testAccOptIn_condition,
+		},
 		"PermissionsBasic": {
 			acctest.CtBasic:       testAccPermissions_basic,
 			"database":            testAccPermissions_database,
@@ -38,6 +43,7 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"dataLocation":        testAccPermissions_dataLocation,
 			acctest.CtDisappears:  testAccPermissions_disappears,
 			"lfTag":               testAccPermissions_lfTag,
+			"lfTagExpressionName": testAccPermissions_lfTagExpressionName,
 			"lfTagPolicy":         testAccPermissions_lfTagPolicy,
 			"lfTagPolicyMultiple": testAccPermissions_lfTagPolicyMultiple,
 		},
@@ -69,6 +75,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"LFTagExpression": {
+			acctest.CtBasic:      testAccLFTagExpression_basic,
+			acctest.CtDisappears: testAccLFTagExpression_disappears,
+			"update":             testAccLFTagExpression_update,
+		},
 		"LFTags": {
 			acctest.CtBasic:      testAccLFTag_basic,
 			acctest.CtDisappears: testAccLFTag_disappears,
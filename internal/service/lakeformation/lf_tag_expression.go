@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="LF Tag Expression")
+func newResourceLFTagExpression(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceLFTagExpression{}
+
+	return r, nil
+}
+
+const (
+	ResNameLFTagExpression = "LF Tag Expression"
+)
+
+type resourceLFTagExpression struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceLFTagExpression) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lakeformation_lf_tag_expression"
+}
+
+func (r *resourceLFTagExpression) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCatalogID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 2048),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrExpression: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[lfTagExpressionTag](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	lfTagExpressionIDPartCount = 2
+)
+
+func (r *resourceLFTagExpression) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan resourceLFTagExpressionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CatalogID.IsUnknown() || plan.CatalogID.IsNull() {
+		plan.CatalogID = fwflex.StringValueToFramework(ctx, r.Meta().AccountID)
+	}
+
+	in := &lakeformation.CreateLFTagExpressionInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateLFTagExpression(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameLFTagExpression, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	idParts := []string{
+		plan.Name.ValueString(),
+		plan.CatalogID.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, lfTagExpressionIDPartCount, false)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionFlatteningResourceId, ResNameLFTagExpression, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceLFTagExpression) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceLFTagExpressionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findLFTagExpressionByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameLFTagExpression, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceLFTagExpression) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan, state resourceLFTagExpressionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.Expression.Equal(state.Expression) {
+		in := &lakeformation.UpdateLFTagExpressionInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateLFTagExpression(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameLFTagExpression, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceLFTagExpression) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceLFTagExpressionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lakeformation.DeleteLFTagExpressionInput{
+		CatalogId: state.CatalogID.ValueStringPointer(),
+		Name:      state.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteLFTagExpression(ctx, in)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionDeleting, ResNameLFTagExpression, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findLFTagExpressionByID(ctx context.Context, conn *lakeformation.Client, id string) (*lakeformation.GetLFTagExpressionOutput, error) {
+	idParts, err := intflex.ExpandResourceId(id, lfTagExpressionIDPartCount, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lakeformation.GetLFTagExpressionInput{
+		Name:      aws.String(idParts[0]),
+		CatalogId: aws.String(idParts[1]),
+	}
+
+	out, err := conn.GetLFTagExpression(ctx, in)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceLFTagExpressionData struct {
+	CatalogID   types.String                                        `tfsdk:"catalog_id"`
+	Description types.String                                        `tfsdk:"description"`
+	Expression  fwtypes.ListNestedObjectValueOf[lfTagExpressionTag] `tfsdk:"expression"`
+	ID          types.String                                        `tfsdk:"id"`
+	Name        types.String                                        `tfsdk:"name"`
+}
+
+type lfTagExpressionTag struct {
+	TagKey    types.String                     `tfsdk:"key"`
+	TagValues fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccLFTagExpression_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var lftagexpression lakeformation.GetLFTagExpressionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(ctx, resourceName, &lftagexpression),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrCatalogID),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "expression.0.key", "aws_lakeformation_lf_tag.test", names.AttrKey),
+					resource.TestCheckResourceAttr(resourceName, "expression.0.values.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "expression.0.values.*", "value1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLFTagExpression_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var lftagexpression lakeformation.GetLFTagExpressionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(ctx, resourceName, &lftagexpression),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceLFTagExpression, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLFTagExpression_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var lftagexpression lakeformation.GetLFTagExpressionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_lf_tag_expression.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLFTagExpressionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLFTagExpressionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(ctx, resourceName, &lftagexpression),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "expression.0.values.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccLFTagExpressionConfig_updated(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLFTagExpressionExists(ctx, resourceName, &lftagexpression),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "expression.0.values.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "expression.0.values.*", "value1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "expression.0.values.*", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLFTagExpressionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_lf_tag_expression" {
+				continue
+			}
+
+			_, err := tflakeformation.FindLFTagExpressionByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameLFTagExpression, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameLFTagExpression, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLFTagExpressionExists(ctx context.Context, name string, lftagexpression *lakeformation.GetLFTagExpressionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameLFTagExpression, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameLFTagExpression, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+		resp, err := tflakeformation.FindLFTagExpressionByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameLFTagExpression, rs.Primary.ID, err)
+		}
+
+		*lftagexpression = *resp
+
+		return nil
+	}
+}
+
+func testAccLFTagExpressionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccLFTagExpressionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_lf_tag_expression" "test" {
+  name = %[1]q
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = ["value1"]
+  }
+}
+`, rName))
+}
+
+func testAccLFTagExpressionConfig_updated(rName, description string) string {
+	return acctest.ConfigCompose(testAccLFTagExpressionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_lf_tag_expression" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  expression {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Opt In")
+func newResourceOptIn(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceOptIn{}
+	r.SetDefaultCreateTimeout(2 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameOptIn = "Opt In"
+)
+
+type resourceOptIn struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[resourceOptInData]
+	framework.WithTimeouts
+}
+
+func (r *resourceOptIn) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lakeformation_opt_in"
+}
+
+func (r *resourceOptIn) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCondition: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[optInCondition](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrExpression: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 3000),
+							},
+						},
+					},
+				},
+			},
+			names.AttrPrincipal: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataLakePrincipal](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_lake_principal_identifier": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+					},
+				},
+			},
+			"resource_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[optInResourceData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"catalog": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInCatalog](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						names.AttrDatabase: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[Database](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"data_cells_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInDataCellsFilter](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"table_catalog_id": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"data_location": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInDataLocation](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrResourceARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"lf_tag": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInLFTagKey](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrValues: schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"lf_tag_policy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInLFTagPolicy](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrResourceType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrExpression: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[optInLFTag](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 128),
+													},
+												},
+												names.AttrValues: schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"table": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInTable](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"table_wildcard": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[optInTableWildcard](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+									},
+								},
+							},
+						},
+						"table_with_columns": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[optInTableWithColumns](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									"column_names": schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringType,
+										Optional:   true,
+									},
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"column_wildcard": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[columnWildcardData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"excluded_column_names": schema.SetAttribute{
+													CustomType: fwtypes.SetOfStringType,
+													Optional:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceOptIn) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan resourceOptInData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lakeformation.CreateLakeFormationOptInInput{}
+	resp.Diagnostics.Append(plan.expand(ctx, &in.Principal, &in.Resource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan.Condition, &in.Condition)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(ctx, IAMPropagationTimeout, func() *retry.RetryError {
+		_, err := conn.CreateLakeFormationOptIn(ctx, in)
+		if err != nil {
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) || errs.IsA[*awstypes.AccessDeniedException](err) {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CreateLakeFormationOptIn(ctx, in)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameOptIn, prettify(in), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+
+	id := fmt.Sprintf("%d", create.StringHashcode(prettify(in)))
+	state.ID = fwflex.StringValueToFramework(ctx, id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, createTimeout, func() (any, error) {
+		return findOptInByPrincipalAndResource(ctx, conn, in.Principal, in.Resource)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionWaitingForCreation, ResNameOptIn, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out := outputRaw.(*awstypes.LakeFormationOptInsInfo)
+	state.LastModified = timetypes.NewRFC3339TimePointerValue(out.LastModified)
+	state.LastUpdatedBy = fwflex.StringToFramework(ctx, out.LastUpdatedBy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceOptIn) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceOptInData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var principal *awstypes.DataLakePrincipal
+	var res *awstypes.Resource
+	resp.Diagnostics.Append(state.expand(ctx, &principal, &res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findOptInByPrincipalAndResource(ctx, conn, principal, res)
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionSetting, ResNameOptIn, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out.Condition != nil {
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, out.Condition, &state.Condition)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	state.LastModified = timetypes.NewRFC3339TimePointerValue(out.LastModified)
+	state.LastUpdatedBy = fwflex.StringToFramework(ctx, out.LastUpdatedBy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceOptIn) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceOptInData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lakeformation.DeleteLakeFormationOptInInput{}
+	resp.Diagnostics.Append(state.expand(ctx, &in.Principal, &in.Resource)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteLakeFormationOptIn(ctx, in)
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionDeleting, ResNameOptIn, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceOptIn) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	resourceData := path.MatchRoot("resource_data").AtListIndex(0)
+
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			resourceData.AtName("catalog"),
+			resourceData.AtName(names.AttrDatabase),
+			resourceData.AtName("data_cells_filter"),
+			resourceData.AtName("data_location"),
+			resourceData.AtName("lf_tag"),
+			resourceData.AtName("lf_tag_policy"),
+			resourceData.AtName("table"),
+			resourceData.AtName("table_with_columns"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			resourceData.AtName("table").AtListIndex(0).AtName(names.AttrName),
+			resourceData.AtName("table").AtListIndex(0).AtName("table_wildcard"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			resourceData.AtName("table_with_columns").AtListIndex(0).AtName("column_names"),
+			resourceData.AtName("table_with_columns").AtListIndex(0).AtName("column_wildcard"),
+		),
+	}
+}
+
+func findOptInByPrincipalAndResource(ctx context.Context, conn *lakeformation.Client, principal *awstypes.DataLakePrincipal, res *awstypes.Resource) (*awstypes.LakeFormationOptInsInfo, error) {
+	in := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: principal,
+		Resource:  res,
+	}
+
+	pages := lakeformation.NewListLakeFormationOptInsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v.Principal != nil && aws.ToString(v.Principal.DataLakePrincipalIdentifier) == aws.ToString(principal.DataLakePrincipalIdentifier) {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+type resourceOptInData struct {
+	Condition     fwtypes.ListNestedObjectValueOf[optInCondition]    `tfsdk:"condition"`
+	ID            types.String                                       `tfsdk:"id"`
+	LastModified  timetypes.RFC3339                                  `tfsdk:"last_modified"`
+	LastUpdatedBy types.String                                       `tfsdk:"last_updated_by"`
+	Principal     fwtypes.ListNestedObjectValueOf[dataLakePrincipal] `tfsdk:"principal"`
+	ResourceData  fwtypes.ListNestedObjectValueOf[optInResourceData] `tfsdk:"resource_data"`
+	Timeouts      timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+func (d *resourceOptInData) expand(ctx context.Context, principal **awstypes.DataLakePrincipal, res **awstypes.Resource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	p, dg := d.Principal.ToPtr(ctx)
+	diags.Append(dg...)
+	if diags.HasError() {
+		return diags
+	}
+
+	rd, dg := d.ResourceData.ToPtr(ctx)
+	diags.Append(dg...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*principal = &awstypes.DataLakePrincipal{}
+	diags.Append(fwflex.Expand(ctx, p, *principal)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	*res = &awstypes.Resource{}
+	diags.Append(fwflex.Expand(ctx, rd, *res)...)
+
+	return diags
+}
+
+type optInCondition struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type dataLakePrincipal struct {
+	DataLakePrincipalIdentifier types.String `tfsdk:"data_lake_principal_identifier"`
+}
+
+type optInResourceData struct {
+	Catalog          fwtypes.ListNestedObjectValueOf[optInCatalog]          `tfsdk:"catalog"`
+	Database         fwtypes.ListNestedObjectValueOf[Database]              `tfsdk:"database"`
+	DataCellsFilter  fwtypes.ListNestedObjectValueOf[optInDataCellsFilter]  `tfsdk:"data_cells_filter"`
+	DataLocation     fwtypes.ListNestedObjectValueOf[optInDataLocation]     `tfsdk:"data_location"`
+	LFTag            fwtypes.ListNestedObjectValueOf[optInLFTagKey]         `tfsdk:"lf_tag"`
+	LFTagPolicy      fwtypes.ListNestedObjectValueOf[optInLFTagPolicy]      `tfsdk:"lf_tag_policy"`
+	Table            fwtypes.ListNestedObjectValueOf[optInTable]            `tfsdk:"table"`
+	TableWithColumns fwtypes.ListNestedObjectValueOf[optInTableWithColumns] `tfsdk:"table_with_columns"`
+}
+
+type optInCatalog struct{}
+
+type optInDataCellsFilter struct {
+	DatabaseName   types.String `tfsdk:"database_name"`
+	Name           types.String `tfsdk:"name"`
+	TableCatalogID types.String `tfsdk:"table_catalog_id"`
+	TableName      types.String `tfsdk:"table_name"`
+}
+
+type optInDataLocation struct {
+	CatalogID   types.String `tfsdk:"catalog_id"`
+	ResourceARN fwtypes.ARN  `tfsdk:"resource_arn"`
+}
+
+type optInLFTagKey struct {
+	CatalogID types.String                     `tfsdk:"catalog_id"`
+	TagKey    types.String                     `tfsdk:"key"`
+	TagValues fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+type optInLFTagPolicy struct {
+	CatalogID    types.String                                `tfsdk:"catalog_id"`
+	Expression   fwtypes.ListNestedObjectValueOf[optInLFTag] `tfsdk:"expression"`
+	ResourceType fwtypes.StringEnum[awstypes.ResourceType]   `tfsdk:"resource_type"`
+}
+
+type optInLFTag struct {
+	TagKey    types.String                     `tfsdk:"key"`
+	TagValues fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+type optInTable struct {
+	CatalogID     types.String                                        `tfsdk:"catalog_id"`
+	DatabaseName  types.String                                        `tfsdk:"database_name"`
+	Name          types.String                                        `tfsdk:"name"`
+	TableWildcard fwtypes.ListNestedObjectValueOf[optInTableWildcard] `tfsdk:"table_wildcard"`
+}
+
+type optInTableWildcard struct{}
+
+type optInTableWithColumns struct {
+	CatalogID      types.String                                        `tfsdk:"catalog_id"`
+	ColumnNames    fwtypes.SetValueOf[types.String]                    `tfsdk:"column_names"`
+	ColumnWildcard fwtypes.ListNestedObjectValueOf[columnWildcardData] `tfsdk:"column_wildcard"`
+	DatabaseName   types.String                                        `tfsdk:"database_name"`
+	Name           types.String                                        `tfsdk:"name"`
+}
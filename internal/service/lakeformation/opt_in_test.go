@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOptIn_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var optin awstypes.LakeFormationOptInsInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			testAccOptInPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName, &optin),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_by"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "principal.0.data_lake_principal_identifier", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "resource_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_data.0.database.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_data.0.database.0.name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccOptIn_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var optin awstypes.LakeFormationOptInsInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			testAccOptInPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName, &optin),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceOptIn, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOptIn_condition(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var optin awstypes.LakeFormationOptInsInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_opt_in.test"
+	expression := `context.datazone.projectId=="test"`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+			testAccOptInPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptInDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptInConfig_condition(rName, expression),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptInExists(ctx, resourceName, &optin),
+					resource.TestCheckResourceAttr(resourceName, "condition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "condition.0.expression", expression),
+					resource.TestCheckResourceAttr(resourceName, "resource_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_data.0.database.0.name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOptInDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_opt_in" {
+				continue
+			}
+
+			principal, res := testAccOptInPrincipalAndResource(rs)
+			_, err := tflakeformation.FindOptInByPrincipalAndResource(ctx, conn, principal, res)
+
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			if err != nil {
+				return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameOptIn, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.LakeFormation, create.ErrActionCheckingDestroyed, tflakeformation.ResNameOptIn, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOptInExists(ctx context.Context, name string, optin *awstypes.LakeFormationOptInsInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameOptIn, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameOptIn, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+		principal, res := testAccOptInPrincipalAndResource(rs)
+		resp, err := tflakeformation.FindOptInByPrincipalAndResource(ctx, conn, principal, res)
+
+		if err != nil {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameOptIn, rs.Primary.ID, err)
+		}
+
+		*optin = *resp
+
+		return nil
+	}
+}
+
+// testAccOptInPrincipalAndResource builds the lookup keys for the database opt-ins used in these tests.
+func testAccOptInPrincipalAndResource(rs *terraform.ResourceState) (*awstypes.DataLakePrincipal, *awstypes.Resource) {
+	principal := &awstypes.DataLakePrincipal{
+		DataLakePrincipalIdentifier: aws.String(rs.Primary.Attributes["principal.0.data_lake_principal_identifier"]),
+	}
+	res := &awstypes.Resource{
+		Database: &awstypes.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["resource_data.0.database.0.name"]),
+		},
+	}
+
+	if v := rs.Primary.Attributes["resource_data.0.database.0.catalog_id"]; v != "" {
+		res.Database.CatalogId = aws.String(v)
+	}
+
+	return principal, res
+}
+
+func testAccOptInPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+	input := &lakeformation.ListLakeFormationOptInsInput{}
+	_, err := conn.ListLakeFormationOptIns(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccOptInConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_opt_in" "test" {
+  principal {
+    data_lake_principal_identifier = aws_iam_role.test.arn
+  }
+
+  resource_data {
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccOptInConfig_condition(rName, expression string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_opt_in" "test" {
+  principal {
+    data_lake_principal_identifier = aws_iam_role.test.arn
+  }
+
+  resource_data {
+    database {
+      name = aws_glue_catalog_database.test.name
+    }
+  }
+
+  condition {
+    expression = %[2]q
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, expression)
+}
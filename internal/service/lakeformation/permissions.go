@@ -220,8 +220,14 @@ func ResourcePermissions() *schema.Resource {
 						},
 						names.AttrExpression: {
 							Type:     schema.TypeSet,
-							Required: true,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
 							MinItems: 1,
+							ExactlyOneOf: []string{
+								"lf_tag_policy.0.expression",
+								"lf_tag_policy.0.expression_name",
+							},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrKey: {
@@ -243,6 +249,12 @@ func ResourcePermissions() *schema.Resource {
 								},
 							},
 						},
+						"expression_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
 						names.AttrResourceType: {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -988,6 +1000,10 @@ func ExpandLFTagPolicyResource(tfMap map[string]interface{}) *awstypes.LFTagPoli
 		apiObject.Expression = ExpandLFTagExpression(v.List())
 	}
 
+	if v, ok := tfMap["expression_name"].(string); ok && v != "" {
+		apiObject.ExpressionName = aws.String(v)
+	}
+
 	if v, ok := tfMap[names.AttrResourceType].(string); ok && v != "" {
 		apiObject.ResourceType = awstypes.ResourceType(v)
 	}
@@ -1026,6 +1042,10 @@ func flattenLFTagPolicyResource(apiObject *awstypes.LFTagPolicyResource) map[str
 		tfMap[names.AttrExpression] = flattenLFTagExpression(v)
 	}
 
+	if v := apiObject.ExpressionName; v != nil {
+		tfMap["expression_name"] = aws.ToString(v)
+	}
+
 	if v := apiObject.ResourceType; v != "" {
 		tfMap[names.AttrResourceType] = string(v)
 	}
@@ -150,8 +150,13 @@ func DataSourcePermissions() *schema.Resource {
 						},
 						names.AttrExpression: {
 							Type:     schema.TypeSet,
-							Required: true,
+							Optional: true,
+							Computed: true,
 							MinItems: 1,
+							ExactlyOneOf: []string{
+								"lf_tag_policy.0.expression",
+								"lf_tag_policy.0.expression_name",
+							},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrKey: {
@@ -171,6 +176,11 @@ func DataSourcePermissions() *schema.Resource {
 								},
 							},
 						},
+						"expression_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
 						names.AttrResourceType: {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -25,6 +25,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceDataCellsFilter,
 			Name:    "Data Cells Filter",
 		},
+		{
+			Factory: newResourceLFTagExpression,
+			Name:    "LF Tag Expression",
+		},
+		{
+			Factory: newResourceOptIn,
+			Name:    "Opt In",
+		},
 		{
 			Factory: newResourceResourceLFTag,
 			Name:    "Resource LF Tag",
@@ -42,6 +42,7 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @Quota(serviceCode="lambda", quotaCode="L-B99A9384", unitsAttribute="reserved_concurrent_executions")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 func resourceFunction() *schema.Resource {
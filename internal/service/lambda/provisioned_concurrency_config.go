@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_lambda_provisioned_concurrency_config", name="Provisioned Concurrency Config")
+// @Quota(serviceCode="lambda", quotaCode="L-B99A9384", unitsAttribute="provisioned_concurrent_executions")
 func resourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedConcurrencyConfigCreate,
@@ -92,6 +92,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode:    "lambda",
+				QuotaCode:      "L-B99A9384",
+				UnitsAttribute: "reserved_concurrent_executions",
+			},
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
@@ -127,6 +132,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 			Name:     "Provisioned Concurrency Config",
+			Quota: &types.ServicePackageResourceQuota{
+				ServiceCode:    "lambda",
+				QuotaCode:      "L-B99A9384",
+				UnitsAttribute: "provisioned_concurrent_executions",
+			},
 		},
 	}
 }
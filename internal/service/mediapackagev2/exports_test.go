@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+// Exports for use in tests only.
+var (
+	ResourceChannel              = newChannelResource
+	ResourceChannelGroup         = newChannelGroupResource
+	ResourceChannelPolicy        = newChannelPolicyResource
+	ResourceOriginEndpoint       = newOriginEndpointResource
+	ResourceOriginEndpointPolicy = newOriginEndpointPolicyResource
+
+	FindChannelByTwoPartKey                = findChannelByTwoPartKey
+	FindChannelGroupByID                   = findChannelGroupByID
+	FindChannelPolicyByTwoPartKey          = findChannelPolicyByTwoPartKey
+	FindOriginEndpointByThreePartKey       = findOriginEndpointByThreePartKey
+	FindOriginEndpointPolicyByThreePartKey = findOriginEndpointPolicyByThreePartKey
+)
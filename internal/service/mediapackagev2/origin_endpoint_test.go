@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaPackageV2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "mediapackagev2", "channelGroup/"+rName+"/channel/"+rName+"/originEndpoint/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "container_type", string(awstypes.ContainerTypeTs)),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifests.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifests.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifests.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"segment"},
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaPackageV2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_cmaf(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaPackageV2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "container_type", string(awstypes.ContainerTypeCmaf)),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifests.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifests.0.manifest_name", "dash"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifests.0.segment_template_format", string(awstypes.DashSegmentTemplateFormatNumberWithTimeline)),
+					resource.TestCheckResourceAttrSet(resourceName, "dash_manifests.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifests.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifests.0.manifest_name", "llhls"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifests.0.manifest_window_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_name", "segment"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaPackageV2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.1.manifest_name", "alternate"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.1.scte_hls.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.1.scte_hls.0.ad_marker_hls", string(awstypes.AdMarkerHlsDaterange)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.MediaPackageV2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string, v *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		output, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+  description        = "updated"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+
+  hls_manifests {
+    manifest_name = "alternate"
+
+    scte_hls {
+      ad_marker_hls = "DATERANGE"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_cmaf(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "CMAF"
+  startover_window_seconds = 300
+
+  segment {
+    segment_duration_seconds = 4
+    segment_name             = "segment"
+
+    scte {
+      scte_filter = ["SPLICE_INSERT", "BREAK"]
+    }
+  }
+
+  low_latency_hls_manifests {
+    manifest_name           = "llhls"
+    manifest_window_seconds = 60
+  }
+
+  dash_manifests {
+    manifest_name           = "dash"
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+
+    utc_timing {
+      timing_mode   = "HTTP_ISO"
+      timing_source = "https://time.akamai.com/?iso"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel.test.channel_group_name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -20,7 +20,37 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newChannelGroupResource,
+			Name:    "Channel Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newChannelPolicyResource,
+			Name:    "Channel Policy",
+		},
+		{
+			Factory: newChannelResource,
+			Name:    "Channel",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newOriginEndpointPolicyResource,
+			Name:    "Origin Endpoint Policy",
+		},
+		{
+			Factory: newOriginEndpointResource,
+			Name:    "Origin Endpoint",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_mediapackagev2_channel_group", &resource.Sweeper{
+		Name: "aws_mediapackagev2_channel_group",
+		F:    sweepChannelGroups,
+		Dependencies: []string{
+			"aws_mediapackagev2_channel",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mediapackagev2_channel", &resource.Sweeper{
+		Name: "aws_mediapackagev2_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_mediapackagev2_origin_endpoint",
+		},
+	})
+
+	resource.AddTestSweepers("aws_mediapackagev2_origin_endpoint", &resource.Sweeper{
+		Name: "aws_mediapackagev2_origin_endpoint",
+		F:    sweepOriginEndpoints,
+	})
+}
+
+func sweepChannelGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.MediaPackageV2Client(ctx)
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaPackage v2 Channel Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MediaPackage v2 Channel Groups (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newChannelGroupResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ChannelGroupName)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MediaPackage v2 Channel Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepChannels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.MediaPackageV2Client(ctx)
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaPackage v2 Channel sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MediaPackage v2 Channel Groups (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: v.ChannelGroupName,
+			}
+
+			pages := mediapackagev2.NewListChannelsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing MediaPackage v2 Channels (%s): %w", region, err)
+				}
+
+				for _, v := range page.Items {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newChannelResource, client,
+						framework.NewAttribute("channel_group_name", aws.ToString(v.ChannelGroupName)),
+						framework.NewAttribute(names.AttrName, aws.ToString(v.ChannelName)),
+					))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MediaPackage v2 Channels (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepOriginEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.MediaPackageV2Client(ctx)
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := mediapackagev2.NewListChannelGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping MediaPackage v2 Origin Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing MediaPackage v2 Channel Groups (%s): %w", region, err)
+		}
+
+		for _, v := range page.Items {
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: v.ChannelGroupName,
+			}
+
+			pages := mediapackagev2.NewListChannelsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing MediaPackage v2 Channels (%s): %w", region, err)
+				}
+
+				for _, v := range page.Items {
+					input := &mediapackagev2.ListOriginEndpointsInput{
+						ChannelGroupName: v.ChannelGroupName,
+						ChannelName:      v.ChannelName,
+					}
+
+					pages := mediapackagev2.NewListOriginEndpointsPaginator(conn, input)
+					for pages.HasMorePages() {
+						page, err := pages.NextPage(ctx)
+
+						if err != nil {
+							return fmt.Errorf("error listing MediaPackage v2 Origin Endpoints (%s): %w", region, err)
+						}
+
+						for _, v := range page.Items {
+							sweepResources = append(sweepResources, framework.NewSweepResource(newOriginEndpointResource, client,
+								framework.NewAttribute("channel_group_name", aws.ToString(v.ChannelGroupName)),
+								framework.NewAttribute("channel_name", aws.ToString(v.ChannelName)),
+								framework.NewAttribute(names.AttrName, aws.ToString(v.OriginEndpointName)),
+							))
+						}
+					}
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MediaPackage v2 Origin Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -68,6 +68,25 @@ func (d *dataSourceRegion) Read(ctx context.Context, request datasource.ReadRequ
 		return
 	}
 
+	// Regions in a partition loaded from a partition metadata file are not known to the AWS SDK.
+	if data.Endpoint.IsNull() {
+		name := d.Meta().Region
+		if !data.Name.IsNull() {
+			name = data.Name.ValueString()
+		}
+
+		if v, ok := d.Meta().CustomPartitionRegion(ctx, name); ok {
+			data.Description = types.StringValue(v.Description)
+			data.Endpoint = types.StringValue(fmt.Sprintf("%s.%s.%s", ec2.EndpointsID, name, d.Meta().DNSSuffix(ctx)))
+			data.ID = types.StringValue(name)
+			data.Name = types.StringValue(name)
+
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+			return
+		}
+	}
+
 	var region *endpoints.Region
 
 	if !data.Endpoint.IsNull() {
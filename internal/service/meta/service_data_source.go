@@ -125,9 +125,18 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	partition, partitionOK := d.Meta().PartitionForRegion(ctx, data.Region.ValueString())
+
 	if data.ReverseDNSPrefix.IsNull() {
-		dnsParts := strings.Split(d.Meta().DNSSuffix(ctx), ".")
-		data.ReverseDNSPrefix = types.StringValue(strings.Join(slices.Reverse(dnsParts), "."))
+		switch {
+		case partitionOK && partition.ReverseDNSPrefix != "":
+			data.ReverseDNSPrefix = types.StringValue(partition.ReverseDNSPrefix)
+		case partitionOK:
+			data.ReverseDNSPrefix = types.StringValue(names.ReverseDNS(partition.DNSSuffix))
+		default:
+			dnsParts := strings.Split(d.Meta().DNSSuffix(ctx), ".")
+			data.ReverseDNSPrefix = types.StringValue(strings.Join(slices.Reverse(dnsParts), "."))
+		}
 	}
 
 	reverseDNSName := fmt.Sprintf("%s.%s.%s", data.ReverseDNSPrefix.ValueString(), data.Region.ValueString(), data.ServiceID.ValueString())
@@ -135,12 +144,9 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 	data.DNSName = types.StringValue(strings.ToLower(strings.Join(slices.Reverse(strings.Split(reverseDNSName, ".")), ".")))
 
 	data.Supported = types.BoolValue(true)
-	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), data.Region.ValueString()); ok {
-		data.Partition = types.StringValue(partition.ID())
-
-		if _, ok := partition.Services()[data.ServiceID.ValueString()]; !ok {
-			data.Supported = types.BoolValue(false)
-		}
+	if partitionOK {
+		data.Partition = types.StringValue(partition.ID)
+		data.Supported = types.BoolValue(serviceSupported(partition.ID, data.Region.ValueString(), data.ServiceID.ValueString()))
 	} else {
 		data.Partition = types.StringNull()
 	}
@@ -150,6 +156,18 @@ func (d *dataSourceService) Read(ctx context.Context, request datasource.ReadReq
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+// serviceSupported returns whether the service is available in the specified partition and Region.
+// Service availability is only known for the AWS SDK's embedded partitions.
+// Services are assumed to be available in partitions loaded from a partition metadata file.
+func serviceSupported(partitionID, region, serviceID string) bool {
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && p.ID() == partitionID {
+		_, ok := p.Services()[serviceID]
+		return ok
+	}
+
+	return true
+}
+
 type dataSourceServiceData struct {
 	DNSName          types.String `tfsdk:"dns_name"`
 	ID               types.String `tfsdk:"id"`
@@ -12,10 +12,70 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestServiceSupported(t *testing.T) {
+	t.Parallel()
+
+	metadata, err := conns.ParsePartitionMetadata([]byte(`{
+  "partitions": [{
+    "id": "aws-test",
+    "dnsSuffix": "example.test",
+    "regions": {"xx-test-1": {"description": "Test (One)"}}
+  }]
+}`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	customPartition, ok := metadata.PartitionForRegion("xx-test-1")
+
+	if !ok {
+		t.Fatal("no custom partition for Region xx-test-1")
+	}
+
+	testCases := map[string]struct {
+		partitionID string
+		region      string
+		serviceID   string
+		expected    bool
+	}{
+		"supported": {
+			partitionID: names.StandardPartitionID,
+			region:      names.USWest2RegionID,
+			serviceID:   ec2.EndpointsID,
+			expected:    true,
+		},
+		"not supported": {
+			partitionID: names.StandardPartitionID,
+			region:      names.USWest2RegionID,
+			serviceID:   "notaservice",
+		},
+		"custom partition": {
+			partitionID: customPartition.ID,
+			region:      "xx-test-1",
+			serviceID:   ec2.EndpointsID,
+			expected:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfmeta.ServiceSupported(testCase.partitionID, testCase.region, testCase.serviceID), testCase.expected; got != want {
+				t.Errorf("ServiceSupported(%q, %q, %q) = %t, want %t", testCase.partitionID, testCase.region, testCase.serviceID, got, want)
+			}
+		})
+	}
+}
+
 func TestAccMetaService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_service.test"
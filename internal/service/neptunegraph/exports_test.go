@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+// Exports for use in tests only.
+var (
+	ResourceGraph                = newGraphResource
+	ResourcePrivateGraphEndpoint = newPrivateGraphEndpointResource
+
+	FindGraphByID                        = findGraphByID
+	FindPrivateGraphEndpointByTwoPartKey = findPrivateGraphEndpointByTwoPartKey
+)
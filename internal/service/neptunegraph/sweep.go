@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_neptunegraph_graph", &resource.Sweeper{
+		Name: "aws_neptunegraph_graph",
+		F:    sweepGraphs,
+		Dependencies: []string{
+			"aws_neptunegraph_private_graph_endpoint",
+		},
+	})
+
+	resource.AddTestSweepers("aws_neptunegraph_private_graph_endpoint", &resource.Sweeper{
+		Name: "aws_neptunegraph_private_graph_endpoint",
+		F:    sweepPrivateGraphEndpoints,
+	})
+}
+
+func sweepGraphs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.NeptuneGraphClient(ctx)
+	input := &neptunegraph.ListGraphsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Neptune Analytics Graph sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Neptune Analytics Graphs (%s): %w", region, err)
+		}
+
+		for _, v := range page.Graphs {
+			id := aws.ToString(v.Id)
+
+			if aws.ToBool(v.DeletionProtection) {
+				log.Printf("[INFO] Disabling deletion protection for Neptune Analytics Graph: %s", id)
+				_, err := conn.UpdateGraph(ctx, &neptunegraph.UpdateGraphInput{
+					DeletionProtection: aws.Bool(false),
+					GraphIdentifier:    aws.String(id),
+				})
+
+				if err != nil {
+					log.Printf("[WARN] Disabling deletion protection for Neptune Analytics Graph (%s): %s", id, err)
+					continue
+				}
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphResource, client,
+				framework.NewAttribute(names.AttrID, id),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Neptune Analytics Graphs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPrivateGraphEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.NeptuneGraphClient(ctx)
+	input := &neptunegraph.ListGraphsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Neptune Analytics Private Graph Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Neptune Analytics Graphs (%s): %w", region, err)
+		}
+
+		for _, v := range page.Graphs {
+			graphID := aws.ToString(v.Id)
+			input := &neptunegraph.ListPrivateGraphEndpointsInput{
+				GraphIdentifier: aws.String(graphID),
+			}
+
+			pages := neptunegraph.NewListPrivateGraphEndpointsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing Neptune Analytics Private Graph Endpoints (%s): %w", region, err)
+				}
+
+				for _, v := range page.PrivateGraphEndpoints {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newPrivateGraphEndpointResource, client,
+						framework.NewAttribute("graph_identifier", graphID),
+						framework.NewAttribute(names.AttrVPCID, aws.ToString(v.VpcId)),
+					))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Neptune Analytics Private Graph Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
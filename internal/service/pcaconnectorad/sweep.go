@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_pcaconnectorad_connector", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_connector",
+		F:    sweepConnectors,
+		Dependencies: []string{
+			"aws_pcaconnectorad_template",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorad_directory_registration", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_directory_registration",
+		F:    sweepDirectoryRegistrations,
+		Dependencies: []string{
+			"aws_pcaconnectorad_connector",
+		},
+	})
+
+	resource.AddTestSweepers("aws_pcaconnectorad_template", &resource.Sweeper{
+		Name: "aws_pcaconnectorad_template",
+		F:    sweepTemplates,
+	})
+}
+
+func sweepConnectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.PCAConnectorADClient(ctx)
+	input := &pcaconnectorad.ListConnectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := pcaconnectorad.NewListConnectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Private CA Connector for Active Directory Connector sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Private CA Connector for Active Directory Connectors (%s): %w", region, err)
+		}
+
+		for _, v := range page.Connectors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newConnectorResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Arn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for Active Directory Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDirectoryRegistrations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.PCAConnectorADClient(ctx)
+	input := &pcaconnectorad.ListDirectoryRegistrationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := pcaconnectorad.NewListDirectoryRegistrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Private CA Connector for Active Directory Directory Registration sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Private CA Connector for Active Directory Directory Registrations (%s): %w", region, err)
+		}
+
+		for _, v := range page.DirectoryRegistrations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDirectoryRegistrationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Arn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for Active Directory Directory Registrations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.PCAConnectorADClient(ctx)
+	input := &pcaconnectorad.ListConnectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := pcaconnectorad.NewListConnectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Private CA Connector for Active Directory Template sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Private CA Connector for Active Directory Connectors (%s): %w", region, err)
+		}
+
+		for _, v := range page.Connectors {
+			input := &pcaconnectorad.ListTemplatesInput{
+				ConnectorArn: v.Arn,
+			}
+
+			pages := pcaconnectorad.NewListTemplatesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing Private CA Connector for Active Directory Templates (%s): %w", region, err)
+				}
+
+				for _, v := range page.Templates {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newTemplateResource, client,
+						framework.NewAttribute(names.AttrID, aws.ToString(v.Arn)),
+					))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Private CA Connector for Active Directory Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	templateVersionPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("template_v2"),
+		path.MatchRelative().AtParent().AtName("template_v3"),
+		path.MatchRelative().AtParent().AtName("template_v4"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(templateVersionPaths...),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(ctx),
+									"enrollment_flags":       enrollmentFlagsBlock(ctx),
+									"extensions":             extensionsBlock(ctx),
+									"general_flags":          generalFlagsBlock(ctx),
+									"private_key_attributes": privateKeyAttributesV2Block(ctx),
+									"private_key_flags": privateKeyFlagsBlock[privateKeyFlagsV2Model, awstypes.ClientCompatibilityV2](ctx,
+										"exportable_key",
+										"strong_key_protection_required",
+									),
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV3Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Required:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(ctx),
+									"enrollment_flags":       enrollmentFlagsBlock(ctx),
+									"extensions":             extensionsBlock(ctx),
+									"general_flags":          generalFlagsBlock(ctx),
+									"private_key_attributes": privateKeyAttributesBlock(ctx, true),
+									"private_key_flags": privateKeyFlagsBlock[privateKeyFlagsV3Model, awstypes.ClientCompatibilityV3](ctx,
+										"exportable_key",
+										"require_alternate_signature_algorithm",
+										"strong_key_protection_required",
+									),
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v4": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV4Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity":   certificateValidityBlock(ctx),
+									"enrollment_flags":       enrollmentFlagsBlock(ctx),
+									"extensions":             extensionsBlock(ctx),
+									"general_flags":          generalFlagsBlock(ctx),
+									"private_key_attributes": privateKeyAttributesBlock(ctx, false),
+									"private_key_flags": privateKeyFlagsBlock[privateKeyFlagsV4Model, awstypes.ClientCompatibilityV4](ctx,
+										"exportable_key",
+										"require_alternate_signature_algorithm",
+										"require_same_key_renewal",
+										"strong_key_protection_required",
+										"use_legacy_provider",
+									),
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &pcaconnectorad.CreateTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := expandTemplateDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Definition = definition
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Private CA Connector for Active Directory Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.setID()
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, template)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		definition, diags := expandTemplateDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &pcaconnectorad.UpdateTemplateInput{
+			Definition:                    definition,
+			ReenrollAllCertificateHolders: fwflex.BoolFromFramework(ctx, new.ReenrollAllCertificateHolders),
+			TemplateArn:                   aws.String(new.ID.ValueString()),
+		}
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		template, err := findTemplateByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, template)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteTemplate(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+func supersededTemplatesAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// optionalComputedBoolAttributes returns Optional+Computed Boolean attributes for the specified names.
+// The service returns a value for every flag, whether or not it was configured.
+func optionalComputedBoolAttributes(attributeNames ...string) map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(attributeNames))
+
+	for _, attributeName := range attributeNames {
+		attributes[attributeName] = schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return attributes
+}
+
+func certificateValidityBlock(ctx context.Context) schema.ListNestedBlock {
+	validityPeriodBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 8766000),
+					},
+				},
+				"period_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"renewal_period":  validityPeriodBlock,
+				"validity_period": validityPeriodBlock,
+			},
+		},
+	}
+}
+
+func enrollmentFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: optionalComputedBoolAttributes(
+				"enable_key_reuse_on_nt_token_keyset_storage_full",
+				"include_symmetric_algorithms",
+				"no_security_extension",
+				"remove_invalid_certificate_from_personal_store",
+				"user_interaction_required",
+			),
+		},
+	}
+}
+
+func extensionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"application_policies": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: optionalComputedBoolAttributes("critical"),
+						Blocks: map[string]schema.Block{
+							"policy": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 100),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"policy_object_identifier": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 64),
+												stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("policy_type")),
+											},
+										},
+										"policy_type": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"key_usage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: optionalComputedBoolAttributes("critical"),
+						Blocks: map[string]schema.Block{
+							"usage_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: optionalComputedBoolAttributes(
+										"data_encipherment",
+										"digital_signature",
+										"key_agreement",
+										"key_encipherment",
+										"non_repudiation",
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func generalFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: optionalComputedBoolAttributes(
+				"auto_enrollment",
+				"machine_type",
+			),
+		},
+	}
+}
+
+func privateKeyAttributesV2Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"crypto_providers": cryptoProvidersAttribute(),
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+// privateKeyAttributesBlock returns the private key attributes schema shared by v3 and v4 templates.
+// v3 templates require the key algorithm and key usage property.
+func privateKeyAttributesBlock(ctx context.Context, v3 bool) schema.ListNestedBlock {
+	keyUsagePropertyValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if v3 {
+		keyUsagePropertyValidators = append(keyUsagePropertyValidators, listvalidator.IsRequired())
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"algorithm": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+					Required:   v3,
+					Optional:   !v3,
+					Computed:   !v3,
+				},
+				"crypto_providers": cryptoProvidersAttribute(),
+				"key_spec": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+					Required:   true,
+				},
+				"minimal_key_length": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"key_usage_property": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+					Validators: keyUsagePropertyValidators,
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"property_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"property_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("property_type")),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: optionalComputedBoolAttributes(
+										"decrypt",
+										"key_agreement",
+										"sign",
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func cryptoProvidersAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 100),
+		},
+	}
+}
+
+func privateKeyFlagsBlock[M any, T enum.Valueser[T]](ctx context.Context, flagNames ...string) schema.ListNestedBlock {
+	attributes := optionalComputedBoolAttributes(flagNames...)
+	attributes["client_version"] = schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[T](),
+		Required:   true,
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[M](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func subjectNameFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: optionalComputedBoolAttributes(
+				"require_common_name",
+				"require_directory_path",
+				"require_dns_as_cn",
+				"require_email",
+				"san_require_directory_guid",
+				"san_require_dns",
+				"san_require_domain_dns",
+				"san_require_email",
+				"san_require_spn",
+				"san_require_upn",
+			),
+		},
+	}
+}
+
+type templateResourceModel struct {
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          types.Map                                                `tfsdk:"tags"`
+	TagsAll                       types.Map                                                `tfsdk:"tags_all"`
+}
+
+func (data *templateResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *templateResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+func (data *templateResourceModel) flatten(ctx context.Context, apiObject *awstypes.Template) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, d := flattenTemplateDefinition(ctx, apiObject.Definition)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Definition = definition
+
+	return diags
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                             `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                           `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.SetValueOf[types.String]                           `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListValueOf[types.String]    `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+type privateKeyAttributesModel struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.ListValueOf[types.String]                      `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int64                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SANRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SANRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SANRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SANRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SANRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SANRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
+
+func expandTemplateDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[templateDefinitionModel]) (awstypes.TemplateDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.TemplateV2.IsNull() {
+		templateV2Data, d := data.TemplateV2.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV2{}
+		diags.Append(fwflex.Expand(ctx, templateV2Data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, templateV2Data.Extensions)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	if !data.TemplateV3.IsNull() {
+		templateV3Data, d := data.TemplateV3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV3{}
+		diags.Append(fwflex.Expand(ctx, templateV3Data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, templateV3Data.Extensions)
+			diags.Append(d...)
+		}
+
+		if v := apiObject.Value.PrivateKeyAttributes; v != nil {
+			v.KeyUsageProperty, d = expandKeyUsageProperty(ctx, templateV3Data.PrivateKeyAttributes)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	if !data.TemplateV4.IsNull() {
+		templateV4Data, d := data.TemplateV4.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.TemplateDefinitionMemberTemplateV4{}
+		diags.Append(fwflex.Expand(ctx, templateV4Data, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if v := apiObject.Value.Extensions; v != nil && v.ApplicationPolicies != nil {
+			v.ApplicationPolicies.Policies, d = expandApplicationPolicies(ctx, templateV4Data.Extensions)
+			diags.Append(d...)
+		}
+
+		if v := apiObject.Value.PrivateKeyAttributes; v != nil {
+			v.KeyUsageProperty, d = expandKeyUsageProperty(ctx, templateV4Data.PrivateKeyAttributes)
+			diags.Append(d...)
+		}
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandApplicationPolicies(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[extensionsModel]) ([]awstypes.ApplicationPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	extensionsData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || extensionsData == nil {
+		return nil, diags
+	}
+
+	applicationPoliciesData, d := extensionsData.ApplicationPolicies.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || applicationPoliciesData == nil {
+		return nil, diags
+	}
+
+	policiesData, d := applicationPoliciesData.Policies.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.ApplicationPolicy
+
+	for _, policyData := range policiesData {
+		switch {
+		case !policyData.PolicyObjectIdentifier.IsNull():
+			apiObjects = append(apiObjects, &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+				Value: policyData.PolicyObjectIdentifier.ValueString(),
+			})
+
+		case !policyData.PolicyType.IsNull():
+			apiObjects = append(apiObjects, &awstypes.ApplicationPolicyMemberPolicyType{
+				Value: policyData.PolicyType.ValueEnum(),
+			})
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func expandKeyUsageProperty(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel]) (awstypes.KeyUsageProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	privateKeyAttributesData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || privateKeyAttributesData == nil {
+		return nil, diags
+	}
+
+	keyUsagePropertyData, d := privateKeyAttributesData.KeyUsageProperty.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || keyUsagePropertyData == nil {
+		return nil, diags
+	}
+
+	if !keyUsagePropertyData.PropertyFlags.IsNull() {
+		propertyFlagsData, d := keyUsagePropertyData.PropertyFlags.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.KeyUsagePropertyMemberPropertyFlags{}
+		diags.Append(fwflex.Expand(ctx, propertyFlagsData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return apiObject, diags
+	}
+
+	if !keyUsagePropertyData.PropertyType.IsNull() {
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: keyUsagePropertyData.PropertyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenTemplateDefinition(ctx context.Context, apiObject awstypes.TemplateDefinition) (fwtypes.ListNestedObjectValueOf[templateDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	data := &templateDefinitionModel{
+		TemplateV2: fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx),
+		TemplateV3: fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx),
+		TemplateV4: fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.TemplateDefinitionMemberTemplateV2:
+		// Members containing union types are flattened separately.
+		value := v.Value
+		value.Extensions = nil
+
+		var templateV2Data templateV2Model
+		diags.Append(fwflex.Flatten(ctx, value, &templateV2Data)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			templateV2Data.Extensions = extensions
+		}
+
+		data.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV2Data)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV3:
+		value := v.Value
+		value.Extensions = nil
+		value.PrivateKeyAttributes = nil
+
+		var templateV3Data templateV3Model
+		diags.Append(fwflex.Flatten(ctx, value, &templateV3Data)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			templateV3Data.Extensions = extensions
+		}
+
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			privateKeyAttributes, d := flattenPrivateKeyAttributes(ctx, &awstypes.PrivateKeyAttributesV4{
+				Algorithm:        v.Algorithm,
+				CryptoProviders:  v.CryptoProviders,
+				KeySpec:          v.KeySpec,
+				KeyUsageProperty: v.KeyUsageProperty,
+				MinimalKeyLength: v.MinimalKeyLength,
+			})
+			diags.Append(d...)
+			templateV3Data.PrivateKeyAttributes = privateKeyAttributes
+		}
+
+		data.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV3Data)
+
+	case *awstypes.TemplateDefinitionMemberTemplateV4:
+		value := v.Value
+		value.Extensions = nil
+		value.PrivateKeyAttributes = nil
+
+		var templateV4Data templateV4Model
+		diags.Append(fwflex.Flatten(ctx, value, &templateV4Data)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+		}
+
+		if v := v.Value.Extensions; v != nil {
+			extensions, d := flattenExtensions(ctx, v.KeyUsage, v.ApplicationPolicies)
+			diags.Append(d...)
+			templateV4Data.Extensions = extensions
+		}
+
+		if v := v.Value.PrivateKeyAttributes; v != nil {
+			privateKeyAttributes, d := flattenPrivateKeyAttributes(ctx, v)
+			diags.Append(d...)
+			templateV4Data.PrivateKeyAttributes = privateKeyAttributes
+		}
+
+		data.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateV4Data)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[templateDefinitionModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+func flattenExtensions(ctx context.Context, keyUsage *awstypes.KeyUsage, applicationPolicies *awstypes.ApplicationPolicies) (fwtypes.ListNestedObjectValueOf[extensionsModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &extensionsModel{
+		ApplicationPolicies: fwtypes.NewListNestedObjectValueOfNull[applicationPoliciesModel](ctx),
+	}
+
+	diags.Append(fwflex.Flatten(ctx, &awstypes.ExtensionsV4{KeyUsage: keyUsage}, data)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[extensionsModel](ctx), diags
+	}
+
+	if applicationPolicies != nil {
+		var policiesData []*applicationPolicyModel
+
+		for _, v := range applicationPolicies.Policies {
+			policyData := &applicationPolicyModel{
+				PolicyObjectIdentifier: types.StringNull(),
+				PolicyType:             fwtypes.StringEnumNull[awstypes.ApplicationPolicyType](),
+			}
+
+			switch v := v.(type) {
+			case *awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+				policyData.PolicyObjectIdentifier = types.StringValue(v.Value)
+
+			case *awstypes.ApplicationPolicyMemberPolicyType:
+				policyData.PolicyType = fwtypes.StringEnumValue(v.Value)
+			}
+
+			policiesData = append(policiesData, policyData)
+		}
+
+		data.ApplicationPolicies = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &applicationPoliciesModel{
+			Critical: fwflex.BoolToFramework(ctx, applicationPolicies.Critical),
+			Policies: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, policiesData),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+func flattenPrivateKeyAttributes(ctx context.Context, apiObject *awstypes.PrivateKeyAttributesV4) (fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value := *apiObject
+	value.KeyUsageProperty = nil
+
+	data := &privateKeyAttributesModel{}
+	diags.Append(fwflex.Flatten(ctx, value, data)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesModel](ctx), diags
+	}
+
+	data.KeyUsageProperty = fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyModel](ctx)
+
+	switch v := apiObject.KeyUsageProperty.(type) {
+	case *awstypes.KeyUsagePropertyMemberPropertyFlags:
+		var propertyFlagsData keyUsagePropertyFlagsModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &propertyFlagsData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[privateKeyAttributesModel](ctx), diags
+		}
+
+		data.KeyUsageProperty = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyModel{
+			PropertyFlags: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &propertyFlagsData),
+			PropertyType:  fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType](),
+		})
+
+	case *awstypes.KeyUsagePropertyMemberPropertyType:
+		data.KeyUsageProperty = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &keyUsagePropertyModel{
+			PropertyFlags: fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx),
+			PropertyType:  fwtypes.StringEnumValue(v.Value),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
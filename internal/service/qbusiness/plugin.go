@@ -0,0 +1,754 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	secretAuthConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[secretAuthConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"build_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginBuildStatus](),
+				Computed:   true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": secretAuthConfigurationBlock,
+						"no_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[noAuthConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("basic_auth_configuration"),
+									path.MatchRelative().AtParent().AtName("no_auth_configuration"),
+									path.MatchRelative().AtParent().AtName("oauth2_client_credential_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"oauth2_client_credential_configuration": secretAuthConfigurationBlock,
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("payload"),
+												path.MatchRelative().AtParent().AtName("s3"),
+											),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	// We can't use AutoFlEx for the authentication or custom plugin configuration because the API structures use Go interfaces.
+	authConfiguration, diags := expandPluginAuthConfiguration(ctx, data.AuthConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	customPluginConfiguration, diags := expandCustomPluginConfiguration(ctx, data.CustomPluginConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &qbusiness.CreatePluginInput{
+		ApplicationId:             aws.String(data.ApplicationID.ValueString()),
+		AuthConfiguration:         authConfiguration,
+		ClientToken:               aws.String(id.UniqueId()),
+		CustomPluginConfiguration: customPluginConfiguration,
+		DisplayName:               aws.String(data.DisplayName.ValueString()),
+		ServerUrl:                 fwflex.StringFromFramework(ctx, data.ServerURL),
+		Tags:                      getTagsIn(ctx),
+		Type:                      data.Type.ValueEnum(),
+	}
+
+	name := data.DisplayName.ValueString()
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Plugin (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+	data.setID()
+
+	plugin, err := waitPluginCreated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The plugin state can't be specified on create.
+	if state := data.State.ValueEnum(); !data.State.IsUnknown() && state != plugin.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: aws.String(data.ApplicationID.ValueString()),
+			PluginId:      aws.String(data.PluginID.ValueString()),
+			State:         state,
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s) state", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err = waitPluginUpdated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+	data.State = fwtypes.StringEnumValue(plugin.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	authConfiguration, diags := flattenPluginAuthConfiguration(ctx, output.AuthConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	customPluginConfiguration, diags := flattenCustomPluginConfiguration(ctx, output.CustomPluginConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.AuthConfiguration = authConfiguration
+	data.BuildStatus = fwtypes.StringEnumValue(output.BuildStatus)
+	data.CustomPluginConfiguration = customPluginConfiguration
+	data.DisplayName = fwflex.StringToFramework(ctx, output.DisplayName)
+	data.ServerURL = fwflex.StringToFramework(ctx, output.ServerUrl)
+	data.State = fwtypes.StringEnumValue(output.State)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.AuthConfiguration.Equal(old.AuthConfiguration) ||
+		!new.CustomPluginConfiguration.Equal(old.CustomPluginConfiguration) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.ServerURL.Equal(old.ServerURL) ||
+		!new.State.Equal(old.State) {
+		authConfiguration, diags := expandPluginAuthConfiguration(ctx, new.AuthConfiguration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		customPluginConfiguration, diags := expandCustomPluginConfiguration(ctx, new.CustomPluginConfiguration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId:             aws.String(new.ApplicationID.ValueString()),
+			AuthConfiguration:         authConfiguration,
+			CustomPluginConfiguration: customPluginConfiguration,
+			DisplayName:               aws.String(new.DisplayName.ValueString()),
+			PluginId:                  aws.String(new.PluginID.ValueString()),
+			ServerUrl:                 fwflex.StringFromFramework(ctx, new.ServerURL),
+		}
+
+		if !new.State.IsUnknown() {
+			input.State = new.State.ValueEnum()
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err := waitPluginUpdated(ctx, conn, new.ApplicationID.ValueString(), new.PluginID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+		new.State = fwtypes.StringEnumValue(plugin.State)
+	} else {
+		new.BuildStatus = old.BuildStatus
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: aws.String(data.ApplicationID.ValueString()),
+		PluginId:      aws.String(data.PluginID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPlugin(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginCreated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusUpdateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandPluginAuthConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]) (awstypes.PluginAuthConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.BasicAuthConfiguration.IsNull() {
+		var apiObject awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration
+		diags.Append(fwflex.Expand(ctx, data.BasicAuthConfiguration, &apiObject.Value)...)
+
+		return &apiObject, diags
+	}
+
+	if !data.NoAuthConfiguration.IsNull() {
+		return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}, diags
+	}
+
+	if !data.OAuth2ClientCredentialConfiguration.IsNull() {
+		var apiObject awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration
+		diags.Append(fwflex.Expand(ctx, data.OAuth2ClientCredentialConfiguration, &apiObject.Value)...)
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenPluginAuthConfiguration(ctx context.Context, apiObject awstypes.PluginAuthConfiguration) (fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &pluginAuthConfigurationModel{
+		BasicAuthConfiguration:              fwtypes.NewListNestedObjectValueOfNull[secretAuthConfigurationModel](ctx),
+		NoAuthConfiguration:                 fwtypes.NewListNestedObjectValueOfNull[noAuthConfigurationModel](ctx),
+		OAuth2ClientCredentialConfiguration: fwtypes.NewListNestedObjectValueOfNull[secretAuthConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		var tfObject secretAuthConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		data.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+
+	case *awstypes.PluginAuthConfigurationMemberNoAuthConfiguration:
+		data.NoAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &noAuthConfigurationModel{})
+
+	case *awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		var tfObject secretAuthConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		data.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[pluginAuthConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+func expandCustomPluginConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel]) (*awstypes.CustomPluginConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.CustomPluginConfiguration{
+		ApiSchemaType: data.APISchemaType.ValueEnum(),
+		Description:   fwflex.StringFromFramework(ctx, data.Description),
+	}
+
+	apiSchema, d := data.APISchema.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if apiSchema != nil {
+		if !apiSchema.Payload.IsNull() {
+			apiObject.ApiSchema = &awstypes.APISchemaMemberPayload{
+				Value: apiSchema.Payload.ValueString(),
+			}
+		} else if !apiSchema.S3.IsNull() {
+			var apiSchemaS3 awstypes.APISchemaMemberS3
+			diags.Append(fwflex.Expand(ctx, apiSchema.S3, &apiSchemaS3.Value)...)
+			apiObject.ApiSchema = &apiSchemaS3
+		}
+	}
+
+	return apiObject, diags
+}
+
+func flattenCustomPluginConfiguration(ctx context.Context, apiObject *awstypes.CustomPluginConfiguration) (fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[customPluginConfigurationModel](ctx), diags
+	}
+
+	apiSchema := &apiSchemaModel{
+		Payload: types.StringNull(),
+		S3:      fwtypes.NewListNestedObjectValueOfNull[s3Model](ctx),
+	}
+
+	switch v := apiObject.ApiSchema.(type) {
+	case *awstypes.APISchemaMemberPayload:
+		apiSchema.Payload = types.StringValue(v.Value)
+
+	case *awstypes.APISchemaMemberS3:
+		var tfObject s3Model
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		apiSchema.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+	}
+
+	data := &customPluginConfigurationModel{
+		APISchema:     fwtypes.NewListNestedObjectValueOfPtrMust(ctx, apiSchema),
+		APISchemaType: fwtypes.StringEnumValue(apiObject.ApiSchemaType),
+		Description:   fwflex.StringToFramework(ctx, apiObject.Description),
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
+
+type pluginResourceModel struct {
+	ApplicationID             types.String                                                    `tfsdk:"application_id"`
+	ARN                       types.String                                                    `tfsdk:"arn"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]   `tfsdk:"auth_configuration"`
+	BuildStatus               fwtypes.StringEnum[awstypes.PluginBuildStatus]                  `tfsdk:"build_status"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                    `tfsdk:"display_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	PluginID                  types.String                                                    `tfsdk:"plugin_id"`
+	ServerURL                 types.String                                                    `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                        `tfsdk:"state"`
+	Tags                      types.Map                                                       `tfsdk:"tags"`
+	TagsAll                   types.Map                                                       `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                         `tfsdk:"type"`
+}
+
+const (
+	pluginResourceIDPartCount = 2
+)
+
+func (m *pluginResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), pluginResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.PluginID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *pluginResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.ApplicationID.ValueString(), m.PluginID.ValueString()}, pluginResourceIDPartCount, false)))
+}
+
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[secretAuthConfigurationModel] `tfsdk:"basic_auth_configuration"`
+	NoAuthConfiguration                 fwtypes.ListNestedObjectValueOf[noAuthConfigurationModel]     `tfsdk:"no_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[secretAuthConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+type secretAuthConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type noAuthConfigurationModel struct{}
+
+type customPluginConfigurationModel struct {
+	APISchema     fwtypes.ListNestedObjectValueOf[apiSchemaModel] `tfsdk:"api_schema"`
+	APISchemaType fwtypes.StringEnum[awstypes.APISchemaType]      `tfsdk:"api_schema_type"`
+	Description   types.String                                    `tfsdk:"description"`
+}
+
+type apiSchemaModel struct {
+	Payload types.String                             `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3Model] `tfsdk:"s3"`
+}
+
+type s3Model struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
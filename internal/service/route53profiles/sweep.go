@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_route53profiles_association", &resource.Sweeper{
+		Name: "aws_route53profiles_association",
+		F:    sweepAssociations,
+	})
+
+	resource.AddTestSweepers("aws_route53profiles_profile", &resource.Sweeper{
+		Name: "aws_route53profiles_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_route53profiles_association",
+			"aws_route53profiles_resource_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_route53profiles_resource_association", &resource.Sweeper{
+		Name: "aws_route53profiles_resource_association",
+		F:    sweepResourceAssociations,
+	})
+}
+
+func sweepAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.Route53ProfilesClient(ctx)
+	input := &route53profiles.ListProfileAssociationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := route53profiles.NewListProfileAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Route 53 Profile Association sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Route 53 Profile Associations (%s): %w", region, err)
+		}
+
+		for _, v := range page.ProfileAssociations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAssociationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+				framework.NewAttribute("profile_id", aws.ToString(v.ProfileId)),
+				framework.NewAttribute(names.AttrResourceID, aws.ToString(v.ResourceId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 Profile Associations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.Route53ProfilesClient(ctx)
+	input := &route53profiles.ListProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := route53profiles.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Route 53 Profile sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Route 53 Profiles (%s): %w", region, err)
+		}
+
+		for _, v := range page.ProfileSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newProfileResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepResourceAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.Route53ProfilesClient(ctx)
+	input := &route53profiles.ListProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := route53profiles.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Route 53 Profile Resource Association sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Route 53 Profiles (%s): %w", region, err)
+		}
+
+		for _, v := range page.ProfileSummaries {
+			input := &route53profiles.ListProfileResourceAssociationsInput{
+				ProfileId: v.Id,
+			}
+
+			pages := route53profiles.NewListProfileResourceAssociationsPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return fmt.Errorf("error listing Route 53 Profile Resource Associations (%s): %w", region, err)
+				}
+
+				for _, v := range page.ProfileResourceAssociations {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newResourceAssociationResource, client,
+						framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+						framework.NewAttribute("profile_id", aws.ToString(v.ProfileId)),
+						framework.NewAttribute(names.AttrResourceARN, aws.ToString(v.ResourceArn)),
+					))
+				}
+			}
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 Profile Resource Associations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+)
+
+// tagsSlice returns route53profiles service tags as a slice.
+// Resource creation takes a list of tags whereas the tagging APIs use a map.
+func tagsSlice(tags map[string]string) []awstypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.Tag, 0, len(tags))
+
+	for k, v := range tags {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
@@ -1127,7 +1127,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	d.Set(names.AttrRegion, region)
-	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(d.Id(), region))
+	dnsSuffix := dnsSuffixForRegion(ctx, meta.(*conns.AWSClient), region)
+	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(d.Id(), region, dnsSuffix))
 
 	hostedZoneID, err := hostedZoneIDForRegion(region)
 	if err != nil {
@@ -1137,7 +1138,7 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	if _, ok := d.GetOk("website"); ok {
-		endpoint, domain := bucketWebsiteEndpointAndDomain(d.Id(), region)
+		endpoint, domain := bucketWebsiteEndpointAndDomain(d.Id(), region, dnsSuffix)
 		d.Set("website_domain", domain)
 		d.Set("website_endpoint", endpoint)
 	}
@@ -1656,16 +1657,26 @@ func retryWhenNoSuchBucketError[T any](ctx context.Context, timeout time.Duratio
 	return outputRaw.(T), nil
 }
 
+// dnsSuffixForRegion returns the DNS suffix of the AWS partition containing the specified Region,
+// defaulting to the configured AWS partition's DNS suffix.
+func dnsSuffixForRegion(ctx context.Context, c *conns.AWSClient, region string) string {
+	if p, ok := c.PartitionForRegion(ctx, region); ok {
+		return p.DNSSuffix
+	}
+
+	return c.DNSSuffix(ctx)
+}
+
 // https://docs.aws.amazon.com/general/latest/gr/rande.html#s3_region
-func bucketRegionalDomainName(bucket, region string) string {
-	// Return a default AWS Commercial domain name if no Region is provided.
+func bucketRegionalDomainName(bucket, region, dnsSuffix string) string {
+	// Return a default domain name if no Region is provided.
 	if region == "" {
-		return fmt.Sprintf("%s.s3.amazonaws.com", bucket) //lintignore:AWSR001
+		return fmt.Sprintf("%s.s3.%s", bucket, dnsSuffix)
 	}
-	return fmt.Sprintf("%s.s3.%s.%s", bucket, region, names.DNSSuffixForPartition(names.PartitionForRegion(region)))
+	return fmt.Sprintf("%s.s3.%s.%s", bucket, region, dnsSuffix)
 }
 
-func bucketWebsiteEndpointAndDomain(bucket, region string) (string, string) {
+func bucketWebsiteEndpointAndDomain(bucket, region, dnsSuffix string) (string, string) {
 	var domain string
 
 	// Default to us-east-1 if the bucket doesn't have a region:
@@ -1689,9 +1700,8 @@ func bucketWebsiteEndpointAndDomain(bucket, region string) (string, string) {
 		names.USWest2RegionID,
 	}
 	if slices.Contains(oldRegions, region) {
-		domain = fmt.Sprintf("s3-website-%s.amazonaws.com", region) //lintignore:AWSR001
+		domain = fmt.Sprintf("s3-website-%s.%s", region, dnsSuffix)
 	} else {
-		dnsSuffix := names.DNSSuffixForPartition(names.PartitionForRegion(region))
 		domain = fmt.Sprintf("s3-website.%s.%s", region, dnsSuffix)
 	}
 
@@ -94,7 +94,8 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set(names.AttrARN, arn)
 	}
 	d.Set("bucket_domain_name", awsClient.PartitionHostname(ctx, bucket+".s3"))
-	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(bucket, region))
+	dnsSuffix := dnsSuffixForRegion(ctx, awsClient, region)
+	d.Set("bucket_regional_domain_name", bucketRegionalDomainName(bucket, region, dnsSuffix))
 	if hostedZoneID, err := hostedZoneIDForRegion(region); err == nil {
 		d.Set(names.AttrHostedZoneID, hostedZoneID)
 	} else {
@@ -102,7 +103,7 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set(names.AttrRegion, region)
 	if _, err := findBucketWebsite(ctx, conn, bucket, ""); err == nil {
-		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, region)
+		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, region, dnsSuffix)
 		d.Set("website_domain", domain)
 		d.Set("website_endpoint", endpoint)
 	} else if !tfresource.NotFound(err) {
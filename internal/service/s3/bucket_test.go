@@ -2357,41 +2357,54 @@ func TestBucketRegionalDomainName(t *testing.T) {
 		ExpectedErrCount int
 		ExpectedOutput   string
 		Region           string
+		DNSSuffix        string
 	}{
 		{
 			Region:           "",
+			DNSSuffix:        "amazonaws.com",
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + ".s3.amazonaws.com",
 		},
 		{
 			Region:           "custom",
+			DNSSuffix:        "amazonaws.com",
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + ".s3.custom.amazonaws.com",
 		},
 		{
 			Region:           names.USEast1RegionID,
+			DNSSuffix:        acctest.PartitionDNSSuffix(),
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + fmt.Sprintf(".s3.%s.%s", names.USEast1RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			Region:           names.USWest2RegionID,
+			DNSSuffix:        acctest.PartitionDNSSuffix(),
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + fmt.Sprintf(".s3.%s.%s", names.USWest2RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			Region:           names.USGovWest1RegionID,
+			DNSSuffix:        acctest.PartitionDNSSuffix(),
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + fmt.Sprintf(".s3.%s.%s", names.USGovWest1RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			Region:           names.CNNorth1RegionID,
+			DNSSuffix:        "amazonaws.com.cn",
 			ExpectedErrCount: 0,
 			ExpectedOutput:   bucket + fmt.Sprintf(".s3.%s.amazonaws.com.cn", names.CNNorth1RegionID),
 		},
+		{
+			Region:           "xx-test-1",
+			DNSSuffix:        testAccCustomPartitionDNSSuffix(t, "xx-test-1"),
+			ExpectedErrCount: 0,
+			ExpectedOutput:   bucket + ".s3.xx-test-1.example.test",
+		},
 	}
 
 	for _, tc := range testCases {
-		output := tfs3.BucketRegionalDomainName(bucket, tc.Region)
+		output := tfs3.BucketRegionalDomainName(bucket, tc.Region, tc.DNSSuffix)
 		if output != tc.ExpectedOutput {
 			t.Fatalf("expected %q, received %q", tc.ExpectedOutput, output)
 		}
@@ -2403,44 +2416,80 @@ func TestWebsiteEndpoint(t *testing.T) {
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/dev/WebsiteEndpoints.html
 	testCases := []struct {
-		TestingClient      *conns.AWSClient
 		LocationConstraint string
+		DNSSuffix          string
 		Expected           string
 	}{
 		{
 			LocationConstraint: "",
+			DNSSuffix:          acctest.PartitionDNSSuffix(),
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", names.USEast1RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			LocationConstraint: names.USEast2RegionID,
+			DNSSuffix:          acctest.PartitionDNSSuffix(),
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", names.USEast2RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			LocationConstraint: names.USGovEast1RegionID,
+			DNSSuffix:          acctest.PartitionDNSSuffix(),
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", names.USGovEast1RegionID, acctest.PartitionDNSSuffix()),
 		},
 		{
 			LocationConstraint: names.USISOEast1RegionID,
+			DNSSuffix:          "c2s.ic.gov",
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.c2s.ic.gov", names.USISOEast1RegionID),
 		},
 		{
 			LocationConstraint: names.USISOBEast1RegionID,
+			DNSSuffix:          "sc2s.sgov.gov",
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.sc2s.sgov.gov", names.USISOBEast1RegionID),
 		},
 		{
 			LocationConstraint: names.CNNorth1RegionID,
+			DNSSuffix:          "amazonaws.com.cn",
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.amazonaws.com.cn", names.CNNorth1RegionID),
 		},
+		{
+			LocationConstraint: "xx-test-1",
+			DNSSuffix:          testAccCustomPartitionDNSSuffix(t, "xx-test-1"),
+			Expected:           "bucket-name.s3-website.xx-test-1.example.test",
+		},
 	}
 
 	for _, testCase := range testCases {
-		got, _ := tfs3.BucketWebsiteEndpointAndDomain("bucket-name", testCase.LocationConstraint)
+		got, _ := tfs3.BucketWebsiteEndpointAndDomain("bucket-name", testCase.LocationConstraint, testCase.DNSSuffix)
 		if got != testCase.Expected {
-			t.Errorf("BucketWebsiteEndpointAndDomain(\"bucket-name\", %q) => %q, want %q", testCase.LocationConstraint, got, testCase.Expected)
+			t.Errorf("BucketWebsiteEndpointAndDomain(\"bucket-name\", %q, %q) => %q, want %q", testCase.LocationConstraint, testCase.DNSSuffix, got, testCase.Expected)
 		}
 	}
 }
 
+// testAccCustomPartitionDNSSuffix returns the DNS suffix for the specified Region from a custom partition metadata fixture.
+func testAccCustomPartitionDNSSuffix(t *testing.T, region string) string {
+	t.Helper()
+
+	metadata, err := conns.ParsePartitionMetadata([]byte(`{
+  "partitions": [{
+    "id": "aws-test",
+    "dnsSuffix": "example.test",
+    "regions": {"xx-test-1": {"description": "Test (One)"}}
+  }]
+}`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	partition, ok := metadata.PartitionForRegion(region)
+
+	if !ok {
+		t.Fatalf("no custom partition for Region %s", region)
+	}
+
+	return partition.DNSSuffix
+}
+
 func testAccCheckBucketDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error { return testAccCheckBucketDestroyWithProvider(ctx)(s, acctest.Provider) }
 }
@@ -2662,12 +2711,12 @@ func testAccCheckBucketDomainName(ctx context.Context, resourceName string, attr
 }
 
 func testAccBucketRegionalDomainName(bucket, region string) string {
-	return tfs3.BucketRegionalDomainName(bucket, region)
+	return tfs3.BucketRegionalDomainName(bucket, region, acctest.PartitionDNSSuffix())
 }
 
 func testAccCheckBucketWebsiteEndpoint(resourceName string, attributeName string, bucketName string, region string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		expectedValue, _ := tfs3.BucketWebsiteEndpointAndDomain(bucketName, region)
+		expectedValue, _ := tfs3.BucketWebsiteEndpointAndDomain(bucketName, region, acctest.PartitionDNSSuffix())
 
 		return resource.TestCheckResourceAttr(resourceName, attributeName, expectedValue)(s)
 	}
@@ -293,7 +293,8 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 	if output, err := findBucketLocation(ctx, conn, bucket, expectedBucketOwner); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Location: %s", d.Id(), err)
 	} else {
-		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, string(output.LocationConstraint))
+		region := string(output.LocationConstraint)
+		endpoint, domain := bucketWebsiteEndpointAndDomain(bucket, region, dnsSuffixForRegion(ctx, meta.(*conns.AWSClient), region))
 		d.Set("website_domain", domain)
 		d.Set("website_endpoint", endpoint)
 	}
@@ -15,13 +15,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func findServiceQuotaDefaultByID(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*types.ServiceQuota, error) {
+func findServiceQuotaDefaultByID(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string, optFns ...func(*servicequotas.Options)) (*types.ServiceQuota, error) {
 	input := &servicequotas.GetAWSDefaultServiceQuotaInput{
 		ServiceCode: aws.String(serviceCode),
 		QuotaCode:   aws.String(quotaCode),
 	}
 
-	output, err := conn.GetAWSDefaultServiceQuota(ctx, input)
+	output, err := conn.GetAWSDefaultServiceQuota(ctx, input, optFns...)
 
 	if err != nil {
 		return nil, err
@@ -55,13 +55,13 @@ func findServiceQuotaDefaultByName(ctx context.Context, conn *servicequotas.Clie
 	return nil, tfresource.NewEmptyResultError(input)
 }
 
-func findServiceQuotaByID(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*types.ServiceQuota, error) {
+func findServiceQuotaByID(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string, optFns ...func(*servicequotas.Options)) (*types.ServiceQuota, error) {
 	input := &servicequotas.GetServiceQuotaInput{
 		ServiceCode: aws.String(serviceCode),
 		QuotaCode:   aws.String(quotaCode),
 	}
 
-	output, err := conn.GetServiceQuota(ctx, input)
+	output, err := conn.GetServiceQuota(ctx, input, optFns...)
 
 	var nsr *types.NoSuchResourceException
 	if errors.As(err, &nsr) {
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Preflight compares planned resource creates with applied Service Quotas values and current usage.
+// Planned creates are accumulated for the lifetime of the Preflight, which is normally a single
+// Terraform plan or apply walk.
+type Preflight struct {
+	lock    sync.Mutex
+	planned map[string]float64
+	quotas  map[string]*preflightQuota
+}
+
+type preflightQuota struct {
+	err    error
+	key    string
+	name   string
+	region string
+	usage  *float64 // nil if the quota has no usage metric.
+	value  float64
+}
+
+// PreflightResult is the outcome of a planned create checked against a quota.
+// A zero-value result has nothing to report.
+type PreflightResult struct {
+	Exceeded     bool
+	UsageUnknown bool
+	Message      string
+}
+
+func NewPreflight() *Preflight {
+	return &Preflight{
+		planned: make(map[string]float64),
+		quotas:  make(map[string]*preflightQuota),
+	}
+}
+
+// PlanCreate records the units of a planned create counted against the specified quota.
+// The result reports whether the planned units together with current usage exceed the applied quota value.
+// If the quota has no usage metric, the unknown usage is reported with the first planned create.
+func (p *Preflight) PlanCreate(ctx context.Context, meta *conns.AWSClient, quota *itypes.ServicePackageResourceQuota, units float64) (PreflightResult, error) {
+	key := quota.ServiceCode + "/" + quota.QuotaCode
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	q, ok := p.quotas[key]
+	if !ok {
+		q = findPreflightQuota(ctx, meta, quota)
+		p.quotas[key] = q
+	}
+
+	if q.err != nil {
+		return PreflightResult{}, q.err
+	}
+
+	if units <= 0 {
+		return PreflightResult{}, nil
+	}
+
+	first := p.planned[key] == 0
+	p.planned[key] += units
+
+	return q.check(p.planned[key], first), nil
+}
+
+// check returns the result of the specified planned units counted against the quota.
+func (q *preflightQuota) check(planned float64, first bool) PreflightResult {
+	if q.usage == nil {
+		if planned > q.value {
+			return PreflightResult{
+				Exceeded:     true,
+				UsageUnknown: true,
+				Message: fmt.Sprintf("%g planned unit(s) counted against Service Quotas quota %q (%s) in %s exceed the applied quota value of %g. Current usage is unknown.",
+					planned, q.name, q.key, q.region, q.value),
+			}
+		}
+
+		if first {
+			return PreflightResult{
+				UsageUnknown: true,
+				Message: fmt.Sprintf("Service Quotas quota %q (%s) in %s has no usage metric, so current usage is unknown. Planned creates are checked against the applied quota value of %g only.",
+					q.name, q.key, q.region, q.value),
+			}
+		}
+
+		return PreflightResult{}
+	}
+
+	if usage := aws.ToFloat64(q.usage); usage+planned > q.value {
+		return PreflightResult{
+			Exceeded: true,
+			Message: fmt.Sprintf("%g planned unit(s) counted against Service Quotas quota %q (%s) in %s, together with current usage of %g, exceed the applied quota value of %g.",
+				planned, q.name, q.key, q.region, usage, q.value),
+		}
+	}
+
+	return PreflightResult{}
+}
+
+func findPreflightQuota(ctx context.Context, meta *conns.AWSClient, quota *itypes.ServicePackageResourceQuota) *preflightQuota {
+	serviceCode, quotaCode := quota.ServiceCode, quota.QuotaCode
+	region := preflightRegion(meta.Partition, meta.Region, quota.Global)
+	conn := meta.ServiceQuotasClient(ctx)
+	optFn := func(o *servicequotas.Options) {
+		o.Region = region
+	}
+
+	output, err := findServiceQuotaByID(ctx, conn, serviceCode, quotaCode, optFn)
+
+	if tfresource.NotFound(err) {
+		output, err = findServiceQuotaDefaultByID(ctx, conn, serviceCode, quotaCode, optFn)
+	}
+
+	if err != nil {
+		return &preflightQuota{err: fmt.Errorf("reading Service Quotas Service Quota (%s/%s): %w", serviceCode, quotaCode, err)}
+	}
+
+	q := &preflightQuota{
+		key:    serviceCode + "/" + quotaCode,
+		name:   aws.ToString(output.QuotaName),
+		region: region,
+		value:  aws.ToFloat64(output.Value),
+	}
+
+	if output.UsageMetric != nil && aws.ToString(output.UsageMetric.MetricName) != "" {
+		usage, err := findQuotaUsage(ctx, meta.CloudWatchClient(ctx), output.UsageMetric, func(o *cloudwatch.Options) {
+			o.Region = region
+		})
+
+		if err != nil {
+			return &preflightQuota{err: fmt.Errorf("reading Service Quotas Service Quota (%s/%s) usage: %w", serviceCode, quotaCode, err)}
+		}
+
+		q.usage = aws.Float64(usage)
+	}
+
+	return q
+}
+
+// preflightRegion returns the Region in which a quota is queried.
+// Quotas for global services are queried in the partition's global endpoint Region.
+func preflightRegion(partition, region string, global bool) string {
+	if !global {
+		return region
+	}
+
+	switch partition {
+	case names.StandardPartitionID:
+		return names.USEast1RegionID
+	case names.ChinaPartitionID:
+		return names.CNNorth1RegionID
+	case names.USGovCloudPartitionID:
+		return names.USGovWest1RegionID
+	default:
+		return region
+	}
+}
+
+// findQuotaUsage returns the most recent value of a quota's CloudWatch usage metric.
+// Usage is reported as zero if no datapoints have been published recently.
+func findQuotaUsage(ctx context.Context, conn *cloudwatch.Client, metric *types.MetricInfo, optFns ...func(*cloudwatch.Options)) (float64, error) {
+	statistic := cloudwatchtypes.StatisticMaximum
+	if v := aws.ToString(metric.MetricStatisticRecommendation); v != "" {
+		statistic = cloudwatchtypes.Statistic(v)
+	}
+
+	now := time.Now()
+	input := &cloudwatch.GetMetricStatisticsInput{
+		EndTime:    aws.Time(now),
+		MetricName: metric.MetricName,
+		Namespace:  metric.MetricNamespace,
+		Period:     aws.Int32(300),
+		StartTime:  aws.Time(now.Add(-1 * time.Hour)),
+		Statistics: []cloudwatchtypes.Statistic{statistic},
+	}
+
+	for k, v := range metric.MetricDimensions {
+		input.Dimensions = append(input.Dimensions, cloudwatchtypes.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := conn.GetMetricStatistics(ctx, input, optFns...)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var latest *cloudwatchtypes.Datapoint
+	for _, v := range output.Datapoints {
+		if latest == nil || aws.ToTime(v.Timestamp).After(aws.ToTime(latest.Timestamp)) {
+			latest = &v
+		}
+	}
+
+	if latest == nil {
+		return 0, nil
+	}
+
+	switch statistic {
+	case cloudwatchtypes.StatisticAverage:
+		return aws.ToFloat64(latest.Average), nil
+	case cloudwatchtypes.StatisticMinimum:
+		return aws.ToFloat64(latest.Minimum), nil
+	case cloudwatchtypes.StatisticSampleCount:
+		return aws.ToFloat64(latest.SampleCount), nil
+	case cloudwatchtypes.StatisticSum:
+		return aws.ToFloat64(latest.Sum), nil
+	default:
+		return aws.ToFloat64(latest.Maximum), nil
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicequotas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPreflightPlanCreate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		quota        *itypes.ServicePackageResourceQuota
+		cached       *preflightQuota
+		units        []float64
+		wantExceeded []bool
+		wantUnknown  []bool
+		wantMessage  []string
+	}{
+		"under limit": {
+			quota: &itypes.ServicePackageResourceQuota{ServiceCode: "vpc", QuotaCode: "L-F678F1CE"},
+			cached: &preflightQuota{
+				key:    "vpc/L-F678F1CE",
+				name:   "VPCs per Region",
+				region: names.USWest2RegionID,
+				usage:  aws.Float64(2),
+				value:  5,
+			},
+			units:        []float64{1, 1},
+			wantExceeded: []bool{false, false},
+			wantUnknown:  []bool{false, false},
+			wantMessage:  []string{"", ""},
+		},
+		"at limit": {
+			quota: &itypes.ServicePackageResourceQuota{ServiceCode: "vpc", QuotaCode: "L-F678F1CE"},
+			cached: &preflightQuota{
+				key:    "vpc/L-F678F1CE",
+				name:   "VPCs per Region",
+				region: names.USWest2RegionID,
+				usage:  aws.Float64(4),
+				value:  5,
+			},
+			units:        []float64{1, 1},
+			wantExceeded: []bool{false, true},
+			wantUnknown:  []bool{false, false},
+			wantMessage:  []string{"", "together with current usage of 4, exceed the applied quota value of 5"},
+		},
+		"units attribute": {
+			quota: &itypes.ServicePackageResourceQuota{ServiceCode: "lambda", QuotaCode: "L-B99A9384", UnitsAttribute: "reserved_concurrent_executions"},
+			cached: &preflightQuota{
+				key:    "lambda/L-B99A9384",
+				name:   "Concurrent executions",
+				region: names.USWest2RegionID,
+				usage:  aws.Float64(500),
+				value:  1000,
+			},
+			units:        []float64{0, 400, 200},
+			wantExceeded: []bool{false, false, true},
+			wantUnknown:  []bool{false, false, false},
+			wantMessage:  []string{"", "", "600 planned unit(s)"},
+		},
+		"no usage metric": {
+			quota: &itypes.ServicePackageResourceQuota{ServiceCode: "ec2", QuotaCode: "L-0263D0A3"},
+			cached: &preflightQuota{
+				key:    "ec2/L-0263D0A3",
+				name:   "EC2-VPC Elastic IPs",
+				region: names.USWest2RegionID,
+				value:  2,
+			},
+			units:        []float64{1, 1, 1},
+			wantExceeded: []bool{false, false, true},
+			wantUnknown:  []bool{true, false, true},
+			wantMessage:  []string{"current usage is unknown", "", "Current usage is unknown."},
+		},
+		"global quota": {
+			quota: &itypes.ServicePackageResourceQuota{ServiceCode: "iam", QuotaCode: "L-FE177D64", Global: true},
+			cached: &preflightQuota{
+				key:    "iam/L-FE177D64",
+				name:   "Roles per account",
+				region: preflightRegion(names.StandardPartitionID, names.USWest2RegionID, true),
+				usage:  aws.Float64(1000),
+				value:  1000,
+			},
+			units:        []float64{1},
+			wantExceeded: []bool{true},
+			wantUnknown:  []bool{false},
+			wantMessage:  []string{"(iam/L-FE177D64) in us-east-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewPreflight()
+			p.quotas[testCase.cached.key] = testCase.cached
+
+			for i, units := range testCase.units {
+				got, err := p.PlanCreate(context.Background(), nil, testCase.quota, units)
+
+				if err != nil {
+					t.Fatalf("PlanCreate #%d: unexpected error: %s", i, err)
+				}
+
+				if got, want := got.Exceeded, testCase.wantExceeded[i]; got != want {
+					t.Errorf("PlanCreate #%d: Exceeded = %t, want %t", i, got, want)
+				}
+
+				if got, want := got.UsageUnknown, testCase.wantUnknown[i]; got != want {
+					t.Errorf("PlanCreate #%d: UsageUnknown = %t, want %t", i, got, want)
+				}
+
+				switch want := testCase.wantMessage[i]; {
+				case want == "" && got.Message != "":
+					t.Errorf("PlanCreate #%d: unexpected message: %s", i, got.Message)
+				case !strings.Contains(got.Message, want):
+					t.Errorf("PlanCreate #%d: message %q does not contain %q", i, got.Message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPreflightRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition string
+		region    string
+		global    bool
+		expected  string
+	}{
+		"regional": {
+			partition: names.StandardPartitionID,
+			region:    names.EUWest1RegionID,
+			expected:  names.EUWest1RegionID,
+		},
+		"global": {
+			partition: names.StandardPartitionID,
+			region:    names.EUWest1RegionID,
+			global:    true,
+			expected:  names.USEast1RegionID,
+		},
+		"global China": {
+			partition: names.ChinaPartitionID,
+			region:    names.CNNorthwest1RegionID,
+			global:    true,
+			expected:  names.CNNorth1RegionID,
+		},
+		"global GovCloud": {
+			partition: names.USGovCloudPartitionID,
+			region:    names.USGovEast1RegionID,
+			global:    true,
+			expected:  names.USGovWest1RegionID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := preflightRegion(testCase.partition, testCase.region, testCase.global), testCase.expected; got != want {
+				t.Errorf("preflightRegion = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func newDBParameterGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &dbParameterGroupResource{}, nil
+}
+
+type dbParameterGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[dbParameterGroupResourceModel]
+	// Timestream for InfluxDB has no API to delete a DB parameter group.
+	framework.WithNoOpDelete
+}
+
+func (*dbParameterGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_timestreaminfluxdb_db_parameter_group"
+}
+
+func (r *dbParameterGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$`), "must start with a letter, contain only alphanumeric characters and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"influxdbv2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[influxDBv2ParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							PlanModifiers: []planmodifier.List{
+								listplanmodifier.RequiresReplace(),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flux_log_enabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogLevel](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"metrics_disabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"no_tasks": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											boolplanmodifier.RequiresReplace(),
+											boolplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"query_concurrency": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+											int64planmodifier.UseStateForUnknown(),
+										},
+									},
+									"query_queue_size": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.RequiresReplace(),
+											int64planmodifier.UseStateForUnknown(),
+										},
+									},
+									"tracing_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TracingType](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dbParameterGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dbParameterGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	// We can't use AutoFlEx for the parameters because the API structure uses Go interfaces.
+	parameters, diags := expandParameters(ctx, data.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &timestreaminfluxdb.CreateDbParameterGroupInput{
+		Description: fwflex.StringFromFramework(ctx, data.Description),
+		Name:        aws.String(name),
+		Parameters:  parameters,
+		Tags:        getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateDbParameterGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Timestream for InfluxDB DB Parameter Group (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	parameters2, diags := flattenParameters(ctx, output.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !data.Parameters.IsNull() {
+		data.Parameters = parameters2
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dbParameterGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dbParameterGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	output, err := findDBParameterGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream for InfluxDB DB Parameter Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	parameters, diags := flattenParameters(ctx, output.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Parameters = parameters
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dbParameterGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	input := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbParameterGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[parametersModel]) (awstypes.Parameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.InfluxDBv2.IsNull() {
+		var apiObject awstypes.ParametersMemberInfluxDBv2
+		diags.Append(fwflex.Expand(ctx, data.InfluxDBv2, &apiObject.Value)...)
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenParameters(ctx context.Context, apiObject awstypes.Parameters) (fwtypes.ListNestedObjectValueOf[parametersModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch v := apiObject.(type) {
+	case *awstypes.ParametersMemberInfluxDBv2:
+		var tfObject influxDBv2ParametersModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[parametersModel](ctx), diags
+		}
+
+		data := &parametersModel{
+			InfluxDBv2: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject),
+		}
+
+		return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[parametersModel](ctx), diags
+}
+
+type dbParameterGroupResourceModel struct {
+	ARN         types.String                                     `tfsdk:"arn"`
+	Description types.String                                     `tfsdk:"description"`
+	ID          types.String                                     `tfsdk:"id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Parameters  fwtypes.ListNestedObjectValueOf[parametersModel] `tfsdk:"parameters"`
+	Tags        types.Map                                        `tfsdk:"tags"`
+	TagsAll     types.Map                                        `tfsdk:"tags_all"`
+}
+
+type parametersModel struct {
+	InfluxDBv2 fwtypes.ListNestedObjectValueOf[influxDBv2ParametersModel] `tfsdk:"influxdbv2"`
+}
+
+type influxDBv2ParametersModel struct {
+	FluxLogEnabled   types.Bool                               `tfsdk:"flux_log_enabled"`
+	LogLevel         fwtypes.StringEnum[awstypes.LogLevel]    `tfsdk:"log_level"`
+	MetricsDisabled  types.Bool                               `tfsdk:"metrics_disabled"`
+	NoTasks          types.Bool                               `tfsdk:"no_tasks"`
+	QueryConcurrency types.Int64                              `tfsdk:"query_concurrency"`
+	QueryQueueSize   types.Int64                              `tfsdk:"query_queue_size"`
+	TracingType      fwtypes.StringEnum[awstypes.TracingType] `tfsdk:"tracing_type"`
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Lens")
+// @Tags(identifierAttribute="arn")
+func newLensResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensResource{}
+
+	return r, nil
+}
+
+type lensResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*lensResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens"
+}
+
+func (r *lensResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"json_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 500000),
+				},
+			},
+			"lens_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *lensResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.ImportLensInput{
+		JSONString: fwflex.StringFromFramework(ctx, data.JSONString),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.ImportLens(ctx, input)
+
+	if err == nil && output.Status == awstypes.ImportLensStatusError {
+		err = fmt.Errorf("import status: %s", output.Status)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Well-Architected Lens", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.LensArn)
+
+	if !data.LensVersion.IsNull() {
+		if err := createLensVersion(ctx, conn, data.ID.ValueString(), data.LensVersion.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findLensByID(ctx, conn, data.ID.ValueString())
+	})
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, lens.(*awstypes.Lens))
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new lensResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+	id := new.ID.ValueString()
+
+	// Re-importing an existing lens creates a new draft version.
+	if !new.JSONString.Equal(old.JSONString) {
+		input := &wellarchitected.ImportLensInput{
+			JSONString: fwflex.StringFromFramework(ctx, new.JSONString),
+			LensAlias:  aws.String(id),
+		}
+
+		output, err := conn.ImportLens(ctx, input)
+
+		if err == nil && output.Status == awstypes.ImportLensStatusError {
+			err = fmt.Errorf("import status: %s", output.Status)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Lens (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.LensVersion.IsNull() && !new.LensVersion.Equal(old.LensVersion) {
+		if err := createLensVersion(ctx, conn, id, new.LensVersion.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing Well-Architected Lens (%s) version", id), err.Error())
+
+			return
+		}
+	}
+
+	lens, err := findLensByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens (%s)", id), err.Error())
+
+		return
+	}
+
+	new.refreshFromOutput(ctx, lens)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *lensResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteLens(ctx, &wellarchitected.DeleteLensInput{
+		LensAlias:  aws.String(data.ID.ValueString()),
+		LensStatus: awstypes.LensStatusTypeAll,
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *lensResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func createLensVersion(ctx context.Context, conn *wellarchitected.Client, id, version string) error {
+	input := &wellarchitected.CreateLensVersionInput{
+		IsMajorVersion: aws.Bool(true),
+		LensAlias:      aws.String(id),
+		LensVersion:    aws.String(version),
+	}
+
+	_, err := conn.CreateLensVersion(ctx, input)
+
+	return err
+}
+
+func findLensByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Lens, error) {
+	input := &wellarchitected.GetLensInput{
+		LensAlias: aws.String(id),
+	}
+
+	output, err := conn.GetLens(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lens == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Lens, nil
+}
+
+type lensResourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	JSONString  types.String `tfsdk:"json_string"`
+	LensVersion types.String `tfsdk:"lens_version"`
+	Name        types.String `tfsdk:"name"`
+	Owner       types.String `tfsdk:"owner"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+}
+
+// refreshFromOutput leaves json_string and lens_version as configured; the
+// API only returns the lens document via ExportLens and reports the version
+// that is currently being read.
+func (m *lensResourceModel) refreshFromOutput(ctx context.Context, lens *awstypes.Lens) {
+	m.ARN = fwflex.StringToFramework(ctx, lens.LensArn)
+	m.Description = fwflex.StringToFramework(ctx, lens.Description)
+	m.ID = fwflex.StringToFramework(ctx, lens.LensArn)
+	m.Name = fwflex.StringToFramework(ctx, lens.Name)
+	m.Owner = fwflex.StringToFramework(ctx, lens.Owner)
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Lens Share")
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensShareResource{}
+
+	return r, nil
+}
+
+type lensShareResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[lensShareResourceModel]
+}
+
+func (*lensShareResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens_share"
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateLensShareInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateLensShare(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Lens Share (%s)", data.LensAlias.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ShareID = fwflex.StringToFramework(ctx, output.ShareId)
+	data.setID()
+
+	share, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findLensShareByTwoPartKey(ctx, conn, data.LensAlias.ValueString(), data.ShareID.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(share.(*awstypes.LensShareSummary).Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findLensShareByTwoPartKey(ctx, conn, data.LensAlias.ValueString(), data.ShareID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.SharedWith = fwflex.StringToFramework(ctx, output.SharedWith)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteLensShare(ctx, &wellarchitected.DeleteLensShareInput{
+		LensAlias: aws.String(data.LensAlias.ValueString()),
+		ShareId:   aws.String(data.ShareID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensAlias, shareID string) (*awstypes.LensShareSummary, error) {
+	input := &wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensAlias),
+	}
+
+	return findLensShare(ctx, conn, input, func(v *awstypes.LensShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+}
+
+func findLensShare(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) (*awstypes.LensShareSummary, error) {
+	output, err := findLensShares(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLensShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) ([]awstypes.LensShareSummary, error) {
+	var output []awstypes.LensShareSummary
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensShareResourceModel struct {
+	ID         types.String                             `tfsdk:"id"`
+	LensAlias  types.String                             `tfsdk:"lens_alias"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+func (m *lensShareResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), lensShareResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.LensAlias = types.StringValue(parts[0])
+	m.ShareID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *lensShareResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.LensAlias.ValueString(), m.ShareID.ValueString()}, lensShareResourceIDPartCount, false)))
+}
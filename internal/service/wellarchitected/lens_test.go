@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedLens_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Lens
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WellArchitected) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`lens/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"json_string", "lens_version"},
+			},
+			{
+				Config: testAccLensConfig_basic(rName, "2.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lens_version", "2.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLens_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Lens
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wellarchitected_lens.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.WellArchitected) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLensDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensConfig_basic(rName, "1.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLens, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLensDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLensExists(ctx context.Context, n string, v *awstypes.Lens) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLensConfig_basic(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_lens" "test" {
+  lens_version = %[2]q
+
+  json_string = jsonencode({
+    schemaVersion = "2021-11-01"
+    name          = %[1]q
+    description   = "test"
+    pillars = [{
+      id   = "pillar1"
+      name = "Pillar 1"
+      questions = [{
+        id          = "question1"
+        title       = "Question 1 (version %[2]s)"
+        description = "Question 1 description"
+        choices = [{
+          id              = "choice1"
+          title           = "Choice 1"
+          helpfulResource = { displayText = "Helpful resource" }
+          improvementPlan = { displayText = "Improvement plan" }
+          }, {
+          id              = "question1_no"
+          title           = "None of these"
+          helpfulResource = { displayText = "Helpful resource" }
+          improvementPlan = { displayText = "Improvement plan" }
+        }]
+        riskRules = [{
+          condition = "choice1"
+          risk      = "NO_RISK"
+          }, {
+          condition = "default"
+          risk      = "HIGH_RISK"
+        }]
+      }]
+    }]
+  })
+}
+`, rName, version)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_wellarchitected_lens", &resource.Sweeper{
+		Name: "aws_wellarchitected_lens",
+		F:    sweepLenses,
+		Dependencies: []string{
+			"aws_wellarchitected_workload",
+		},
+	})
+
+	resource.AddTestSweepers("aws_wellarchitected_workload", &resource.Sweeper{
+		Name: "aws_wellarchitected_workload",
+		F:    sweepWorkloads,
+	})
+}
+
+func sweepLenses(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WellArchitectedClient(ctx)
+	input := &wellarchitected.ListLensesInput{
+		LensType: awstypes.LensTypeCustomSelf,
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := wellarchitected.NewListLensesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Well-Architected Lens sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Well-Architected Lenses (%s): %w", region, err)
+		}
+
+		for _, v := range page.LensSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newLensResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.LensArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Well-Architected Lenses (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWorkloads(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WellArchitectedClient(ctx)
+	input := &wellarchitected.ListWorkloadsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := wellarchitected.NewListWorkloadsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Well-Architected Workload sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing Well-Architected Workloads (%s): %w", region, err)
+		}
+
+		for _, v := range page.WorkloadSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newWorkloadResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WorkloadId)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Well-Architected Workloads (%s): %w", region, err)
+	}
+
+	return nil
+}
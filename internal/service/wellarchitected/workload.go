@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Workload")
+// @Tags(identifierAttribute="arn")
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadResource{}
+
+	return r, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*workloadResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_workload"
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2048),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"improvement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadImprovementStatus](),
+				Computed:   true,
+			},
+			"industry": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(5),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateWorkloadInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	name := data.WorkloadName.ValueString()
+	output, err := conn.CreateWorkload(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.WorkloadId)
+
+	workload, err := findWorkloadByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, workload)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findWorkloadByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.refreshFromOutput(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+	id := new.ID.ValueString()
+
+	if !new.AccountIDs.Equal(old.AccountIDs) ||
+		!new.ArchitecturalDesign.Equal(old.ArchitecturalDesign) ||
+		!new.AWSRegions.Equal(old.AWSRegions) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Environment.Equal(old.Environment) ||
+		!new.Industry.Equal(old.Industry) ||
+		!new.IndustryType.Equal(old.IndustryType) ||
+		!new.NonAWSRegions.Equal(old.NonAWSRegions) ||
+		!new.Notes.Equal(old.Notes) ||
+		!new.PillarPriorities.Equal(old.PillarPriorities) ||
+		!new.ReviewOwner.Equal(old.ReviewOwner) ||
+		!new.WorkloadName.Equal(old.WorkloadName) {
+		input := &wellarchitected.UpdateWorkloadInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.WorkloadId = aws.String(id)
+		if !new.ReviewOwner.Equal(old.ReviewOwner) {
+			input.IsReviewOwnerUpdateAcknowledged = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateWorkload(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Lenses.Equal(old.Lenses) {
+		add, remove := setDifference(ctx, new.Lenses, old.Lenses)
+
+		// Associate before disassociating so that the workload always has at least one lens.
+		if len(add) > 0 {
+			input := &wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.AssociateLenses(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+
+		if len(remove) > 0 {
+			input := &wellarchitected.DisassociateLensesInput{
+				LensAliases: remove,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.DisassociateLenses(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.Equal(old.ProfileARNs) {
+		add, remove := setDifference(ctx, new.ProfileARNs, old.ProfileARNs)
+
+		if len(remove) > 0 {
+			input := &wellarchitected.DisassociateProfilesInput{
+				ProfileArns: remove,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.DisassociateProfiles(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) profiles", id), err.Error())
+
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			input := &wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.AssociateProfiles(ctx, input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) profiles", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.refreshFromOutput(ctx, workload)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteWorkload(ctx, &wellarchitected.DeleteWorkloadInput{
+		WorkloadId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *workloadResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// setDifference returns the elements to add to and remove from old to obtain new.
+func setDifference(ctx context.Context, new, old fwtypes.SetValueOf[types.String]) ([]string, []string) {
+	n, o := fwflex.ExpandFrameworkStringValueSet(ctx, new), fwflex.ExpandFrameworkStringValueSet(ctx, old)
+
+	return n.Difference(o), o.Difference(n)
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := &wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	AccountIDs          fwtypes.SetValueOf[types.String]                       `tfsdk:"account_ids"`
+	ArchitecturalDesign types.String                                           `tfsdk:"architectural_design"`
+	ARN                 types.String                                           `tfsdk:"arn"`
+	AWSRegions          fwtypes.SetValueOf[types.String]                       `tfsdk:"aws_regions"`
+	Description         types.String                                           `tfsdk:"description"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment]       `tfsdk:"environment"`
+	ID                  types.String                                           `tfsdk:"id"`
+	ImprovementStatus   fwtypes.StringEnum[awstypes.WorkloadImprovementStatus] `tfsdk:"improvement_status"`
+	Industry            types.String                                           `tfsdk:"industry"`
+	IndustryType        types.String                                           `tfsdk:"industry_type"`
+	Lenses              fwtypes.SetValueOf[types.String]                       `tfsdk:"lenses"`
+	NonAWSRegions       fwtypes.SetValueOf[types.String]                       `tfsdk:"non_aws_regions"`
+	Notes               types.String                                           `tfsdk:"notes"`
+	Owner               types.String                                           `tfsdk:"owner"`
+	PillarPriorities    fwtypes.ListValueOf[types.String]                      `tfsdk:"pillar_priorities"`
+	ProfileARNs         fwtypes.SetValueOf[types.String]                       `tfsdk:"profile_arns"`
+	ReviewOwner         types.String                                           `tfsdk:"review_owner"`
+	Tags                types.Map                                              `tfsdk:"tags"`
+	TagsAll             types.Map                                              `tfsdk:"tags_all"`
+	WorkloadName        types.String                                           `tfsdk:"workload_name"`
+}
+
+func (m *workloadResourceModel) refreshFromOutput(ctx context.Context, workload *awstypes.Workload) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, workload, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.ARN = fwflex.StringToFramework(ctx, workload.WorkloadArn)
+	m.ID = fwflex.StringToFramework(ctx, workload.WorkloadId)
+
+	// Profile associations are returned as a list of structures.
+	if len(workload.Profiles) > 0 {
+		var d diag.Diagnostics
+		m.ProfileARNs, d = fwtypes.NewSetValueOf[types.String](ctx, tfslices.ApplyToAll(workload.Profiles, func(v awstypes.WorkloadProfile) attr.Value {
+			return fwflex.StringToFramework(ctx, v.ProfileArn)
+		}))
+		diags.Append(d...)
+	} else {
+		m.ProfileARNs = fwtypes.NewSetValueOfNull[types.String](ctx)
+	}
+
+	return diags
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Identity Provider")
+func newIdentityProviderResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &identityProviderResource{}
+
+	return r, nil
+}
+
+type identityProviderResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*identityProviderResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_identity_provider"
+}
+
+func (r *identityProviderResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID:            framework.IDAttribute(),
+			"identity_provider_arn": framework.ARNAttributeComputedOnly(),
+			"identity_provider_details": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"identity_provider_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"identity_provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IdentityProviderType](),
+				Required:   true,
+			},
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *identityProviderResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	name := data.IdentityProviderName.ValueString()
+	input := &workspacesweb.CreateIdentityProviderInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateIdentityProvider(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Web Identity Provider (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IdentityProviderARN = fwflex.StringToFramework(ctx, output.IdentityProviderArn)
+	data.setID()
+
+	if _, err := findIdentityProviderByARN(ctx, conn, data.ID.ValueString()); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Identity Provider (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *identityProviderResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findIdentityProviderByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Identity Provider (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The portal ARN is the prefix of the identity provider ARN.
+	// e.g. arn:aws:workspaces-web:us-west-2:123456789012:identityProvider/<portal-id>/<identity-provider-id>.
+	if data.PortalARN.IsNull() {
+		portalARN, err := portalARNFromIdentityProviderARN(data.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError("parsing WorkSpaces Web Identity Provider ARN", err.Error())
+
+			return
+		}
+
+		data.PortalARN = fwtypes.ARNValue(portalARN)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *identityProviderResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new identityProviderResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.IdentityProviderDetails.Equal(old.IdentityProviderDetails) ||
+		!new.IdentityProviderName.Equal(old.IdentityProviderName) ||
+		!new.IdentityProviderType.Equal(old.IdentityProviderType) {
+		input := &workspacesweb.UpdateIdentityProviderInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(id.UniqueId())
+
+		_, err := conn.UpdateIdentityProvider(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Identity Provider (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *identityProviderResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data identityProviderResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteIdentityProvider(ctx, &workspacesweb.DeleteIdentityProviderInput{
+		IdentityProviderArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Identity Provider (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findIdentityProviderByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.IdentityProvider, error) {
+	input := &workspacesweb.GetIdentityProviderInput{
+		IdentityProviderArn: aws.String(arn),
+	}
+
+	output, err := conn.GetIdentityProvider(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IdentityProvider == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IdentityProvider, nil
+}
+
+type identityProviderResourceModel struct {
+	ID                      types.String                                      `tfsdk:"id"`
+	IdentityProviderARN     types.String                                      `tfsdk:"identity_provider_arn"`
+	IdentityProviderDetails fwtypes.MapValueOf[types.String]                  `tfsdk:"identity_provider_details"`
+	IdentityProviderName    types.String                                      `tfsdk:"identity_provider_name"`
+	IdentityProviderType    fwtypes.StringEnum[awstypes.IdentityProviderType] `tfsdk:"identity_provider_type"`
+	PortalARN               fwtypes.ARN                                       `tfsdk:"portal_arn"`
+}
+
+func (data *identityProviderResourceModel) InitFromID() error {
+	data.IdentityProviderARN = data.ID
+
+	return nil
+}
+
+func (data *identityProviderResourceModel) setID() {
+	data.ID = data.IdentityProviderARN
+}
+
+func portalARNFromIdentityProviderARN(identityProviderARN string) (string, error) {
+	parsedARN, err := arn.Parse(identityProviderARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != "identityProvider" || parts[1] == "" {
+		return "", fmt.Errorf("unexpected format for ARN (%s)", identityProviderARN)
+	}
+
+	parsedARN.Resource = "portal/" + parts[1]
+
+	return parsedARN.String(), nil
+}
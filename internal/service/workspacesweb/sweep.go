@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_workspacesweb_browser_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_browser_settings",
+		F:    sweepBrowserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+	resource.AddTestSweepers("aws_workspacesweb_ip_access_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_ip_access_settings",
+		F:    sweepIPAccessSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+	resource.AddTestSweepers("aws_workspacesweb_network_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_network_settings",
+		F:    sweepNetworkSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+	resource.AddTestSweepers("aws_workspacesweb_portal", &resource.Sweeper{
+		Name: "aws_workspacesweb_portal",
+		F:    sweepPortals,
+	})
+	resource.AddTestSweepers("aws_workspacesweb_trust_store", &resource.Sweeper{
+		Name: "aws_workspacesweb_trust_store",
+		F:    sweepTrustStores,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+	resource.AddTestSweepers("aws_workspacesweb_user_access_logging_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_user_access_logging_settings",
+		F:    sweepUserAccessLoggingSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+	resource.AddTestSweepers("aws_workspacesweb_user_settings", &resource.Sweeper{
+		Name: "aws_workspacesweb_user_settings",
+		F:    sweepUserSettings,
+		Dependencies: []string{
+			"aws_workspacesweb_portal",
+		},
+	})
+}
+
+func sweepBrowserSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListBrowserSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListBrowserSettingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web Browser Settings sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web Browser Settings (%s): %w", region, err)
+		}
+
+		for _, v := range page.BrowserSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newBrowserSettingsResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.BrowserSettingsArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Browser Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepIPAccessSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListIpAccessSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListIpAccessSettingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web IP Access Settings sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web IP Access Settings (%s): %w", region, err)
+		}
+
+		for _, v := range page.IpAccessSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newIPAccessSettingsResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.IpAccessSettingsArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web IP Access Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepNetworkSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListNetworkSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListNetworkSettingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web Network Settings sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web Network Settings (%s): %w", region, err)
+		}
+
+		for _, v := range page.NetworkSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newNetworkSettingsResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.NetworkSettingsArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Network Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPortals(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListPortalsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListPortalsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web Portal sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web Portals (%s): %w", region, err)
+		}
+
+		for _, v := range page.Portals {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPortalResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.PortalArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Portals (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepTrustStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListTrustStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListTrustStoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web Trust Store sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web Trust Stores (%s): %w", region, err)
+		}
+
+		for _, v := range page.TrustStores {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newTrustStoreResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.TrustStoreArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web Trust Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepUserAccessLoggingSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListUserAccessLoggingSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListUserAccessLoggingSettingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web User Access Logging Settings sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web User Access Logging Settings (%s): %w", region, err)
+		}
+
+		for _, v := range page.UserAccessLoggingSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newUserAccessLoggingSettingsResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.UserAccessLoggingSettingsArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web User Access Logging Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepUserSettings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.WorkSpacesWebClient(ctx)
+	input := &workspacesweb.ListUserSettingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := workspacesweb.NewListUserSettingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping WorkSpaces Web User Settings sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing WorkSpaces Web User Settings (%s): %w", region, err)
+		}
+
+		for _, v := range page.UserSettings {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newUserSettingsResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.UserSettingsArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping WorkSpaces Web User Settings (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trust Store")
+// @Tags(identifierAttribute="trust_store_arn")
+func newTrustStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &trustStoreResource{}
+
+	return r, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*trustStoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store"
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associated_portal_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_list": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn": framework.ARNAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateTrustStoreInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.CertificateList = expandCertificateBodies(fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList))
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TrustStoreARN = fwflex.StringToFramework(ctx, output.TrustStoreArn)
+	data.setID()
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, trustStore, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, tfslices.ApplyToAll(certificates, func(v awstypes.Certificate) string {
+		return string(v.Body)
+	}), &data.CertificateList)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.CertificateList.Equal(old.CertificateList) {
+		// Certificates are removed by thumbprint.
+		certificates, err := findTrustStoreCertificatesByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.CertificateList), fwflex.ExpandFrameworkStringValueSet(ctx, new.CertificateList)
+		add, del := n.Difference(o), o.Difference(n)
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			CertificatesToAdd: expandCertificateBodies(add),
+			ClientToken:       aws.String(id.UniqueId()),
+			TrustStoreArn:     aws.String(new.ID.ValueString()),
+		}
+
+		for _, v := range certificates {
+			if slices.Contains(del, string(v.Body)) {
+				input.CertificatesToDelete = append(input.CertificatesToDelete, aws.ToString(v.Thumbprint))
+			}
+		}
+
+		_, err = conn.UpdateTrustStore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteTrustStore(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListValueOf[types.String] `tfsdk:"associated_portal_arns"`
+	CertificateList      fwtypes.SetValueOf[types.String]  `tfsdk:"certificate_list"`
+	ID                   types.String                      `tfsdk:"id"`
+	Tags                 types.Map                         `tfsdk:"tags"`
+	TagsAll              types.Map                         `tfsdk:"tags_all"`
+	TrustStoreARN        types.String                      `tfsdk:"trust_store_arn"`
+}
+
+func (data *trustStoreResourceModel) InitFromID() error {
+	data.TrustStoreARN = data.ID
+
+	return nil
+}
+
+func (data *trustStoreResourceModel) setID() {
+	data.ID = data.TrustStoreARN
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.Certificate, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.Certificate
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CertificateList {
+			input := &workspacesweb.GetTrustStoreCertificateInput{
+				Thumbprint:    v.Thumbprint,
+				TrustStoreArn: aws.String(arn),
+			}
+
+			certificate, err := conn.GetTrustStoreCertificate(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate == nil || certificate.Certificate == nil {
+				return nil, tfresource.NewEmptyResultError(input)
+			}
+
+			output = append(output, *certificate.Certificate)
+		}
+	}
+
+	return output, nil
+}
+
+func expandCertificateBodies(tfList []string) [][]byte {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return tfslices.ApplyToAll(tfList, func(v string) []byte {
+		return []byte(v)
+	})
+}
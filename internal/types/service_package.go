@@ -17,6 +17,15 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceQuota represents resource-level Service Quotas information.
+// Each planned create of the resource counts as one unit against the quota unless UnitsAttribute is set.
+type ServicePackageResourceQuota struct {
+	ServiceCode    string // The Service Quotas service code, e.g. "vpc"
+	QuotaCode      string // The Service Quotas quota code, e.g. "L-F678F1CE"
+	Global         bool   // Whether the quota is for a global service and is queried in the partition's global endpoint region, e.g. IAM
+	UnitsAttribute string // Optional top-level number attribute whose planned value is counted against the quota
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -31,6 +40,7 @@ type ServicePackageFrameworkResource struct {
 	Factory func(context.Context) (resource.ResourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	Quota   *ServicePackageResourceQuota
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -49,4 +59,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Quota    *ServicePackageResourceQuota
 }